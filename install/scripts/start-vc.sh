@@ -90,6 +90,12 @@ if [ "$CC_CLIENT" = "lighthouse" ]; then
         CMD="$CMD --metrics --metrics-address 0.0.0.0 --metrics-port $VC_METRICS_PORT"
     fi
 
+    # The Keymanager API is only reachable from inside Docker, the node daemon authenticates with the generated api-token.txt.
+    # Web3Signer keys are also loaded through it, Lighthouse keeps them in its validator definitions.
+    if [ "$ENABLE_KEYMANAGER_API" = "true" ]; then
+        CMD="$CMD --http --http-address 0.0.0.0 --http-port $KEYMANAGER_API_PORT --unencrypted-http-transport"
+    fi
+
     if [ "$ENABLE_BITFLY_NODE_METRICS" = "true" ]; then
         CMD="$CMD --monitoring-endpoint $BITFLY_NODE_METRICS_ENDPOINT?apikey=$BITFLY_NODE_METRICS_SECRET&machine=$BITFLY_NODE_METRICS_MACHINE_NAME"
     fi
@@ -138,6 +144,10 @@ if [ "$CC_CLIENT" = "lodestar" ]; then
         CMD="$CMD --metrics --metrics.address 0.0.0.0 --metrics.port $VC_METRICS_PORT"
     fi
 
+    if [ "$ENABLE_WEB3SIGNER" = "true" ]; then
+        CMD="$CMD --externalSigner.url $WEB3SIGNER_URL --externalSigner.fetch"
+    fi
+
     exec ${CMD} --graffiti "$GRAFFITI"
 
 fi
@@ -173,6 +183,18 @@ if [ "$CC_CLIENT" = "nimbus" ]; then
         CMD="$CMD --metrics --metrics-address=0.0.0.0 --metrics-port=$VC_METRICS_PORT"
     fi
 
+    # Nimbus doesn't generate a Keymanager API token, so create one for the node daemon to use
+    if [ "$ENABLE_KEYMANAGER_API" = "true" ]; then
+        if [ ! -s /validators/nimbus/keymanager-token.txt ]; then
+            head -c 32 /dev/urandom | od -A n -t x1 | tr -d ' \n' > /validators/nimbus/keymanager-token.txt
+        fi
+        CMD="$CMD --keymanager --keymanager-address=0.0.0.0 --keymanager-port=$KEYMANAGER_API_PORT --keymanager-token-file=/validators/nimbus/keymanager-token.txt"
+    fi
+
+    if [ "$ENABLE_WEB3SIGNER" = "true" ]; then
+        CMD="$CMD --web3-signer-url=$WEB3SIGNER_URL"
+    fi
+
     # Graffiti breaks if it's in the CMD string instead of here because of spaces
     exec ${CMD} --graffiti="$GRAFFITI"
 
@@ -201,11 +223,17 @@ if [ "$CC_CLIENT" = "prysm" ]; then
         --accept-terms-of-use \
         $PRYSM_NETWORK \
         --wallet-dir /validators/prysm-non-hd \
-        --wallet-password-file /validators/prysm-non-hd/direct/accounts/secret \
         --beacon-rpc-provider $CC_URL_STRING \
         --suggested-fee-recipient $(cat /validators/$FEE_RECIPIENT_FILE) \
         $VC_ADDITIONAL_FLAGS"
 
+    # Prysm signs with either the local wallet or the Web3Signer
+    if [ "$ENABLE_WEB3SIGNER" = "true" ]; then
+        CMD="$CMD --validators-external-signer-url $WEB3SIGNER_URL --validators-external-signer-public-keys $WEB3SIGNER_URL/api/v1/eth2/publicKeys"
+    else
+        CMD="$CMD --wallet-password-file /validators/prysm-non-hd/direct/accounts/secret"
+    fi
+
     if [ "$ENABLE_MEV_BOOST" = "true" ]; then
         CMD="$CMD --enable-builder"
     fi
@@ -220,6 +248,11 @@ if [ "$CC_CLIENT" = "prysm" ]; then
         CMD="$CMD --disable-account-metrics"
     fi
 
+    # Prysm serves the Keymanager API on its gateway and writes the token to auth-token in the wallet dir
+    if [ "$ENABLE_KEYMANAGER_API" = "true" ]; then
+        CMD="$CMD --rpc --grpc-gateway-host 0.0.0.0 --grpc-gateway-port $KEYMANAGER_API_PORT"
+    fi
+
 
     exec ${CMD} --graffiti "$GRAFFITI"
 
@@ -261,6 +294,15 @@ if [ "$CC_CLIENT" = "teku" ]; then
         CMD="$CMD --metrics-enabled=true --metrics-interface=0.0.0.0 --metrics-port=$VC_METRICS_PORT --metrics-host-allowlist=*"
     fi
 
+    # Teku writes the Keymanager API token to validator/key-manager/validator-api-bearer in its data path
+    if [ "$ENABLE_KEYMANAGER_API" = "true" ]; then
+        CMD="$CMD --validator-api-enabled=true --validator-api-interface=0.0.0.0 --validator-api-port=$KEYMANAGER_API_PORT --validator-api-host-allowlist=* --validator-api-ssl-enabled=false"
+    fi
+
+    if [ "$ENABLE_WEB3SIGNER" = "true" ]; then
+        CMD="$CMD --validators-external-signer-url=$WEB3SIGNER_URL --validators-external-signer-public-keys=external-signer"
+    fi
+
     if [ "$ENABLE_BITFLY_NODE_METRICS" = "true" ]; then
         CMD="$CMD --metrics-publish-endpoint=$BITFLY_NODE_METRICS_ENDPOINT?apikey=$BITFLY_NODE_METRICS_SECRET&machine=$BITFLY_NODE_METRICS_MACHINE_NAME"
     fi
@@ -20,8 +20,10 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/stader-labs/stader-node/shared/services/beacon"
@@ -33,6 +35,9 @@ import (
 	"github.com/stader-labs/stader-node/stader-lib/types"
 )
 
+// Time to wait before reopening a failed event stream
+const eventStreamRetryDelay = 5 * time.Second
+
 // This is a proxy for multiple Beacon clients, providing natural fallback support if one of them fails.
 type BeaconClientManager struct {
 	primaryBc       beacon.Client
@@ -304,6 +309,72 @@ func (m *BeaconClientManager) GetCommitteesForEpoch(epoch *uint64) ([]beacon.Com
 	return result.([]beacon.Committee), nil
 }
 
+// Get the attestation rewards of validators for an epoch
+func (m *BeaconClientManager) GetAttestationRewards(epoch uint64, indices []uint64) (beacon.AttestationRewards, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetAttestationRewards(epoch, indices)
+	})
+	if err != nil {
+		return beacon.AttestationRewards{}, err
+	}
+	return result.(beacon.AttestationRewards), nil
+}
+
+// Get the proposer rewards of a block
+func (m *BeaconClientManager) GetBlockRewards(blockId string) (beacon.BlockRewards, bool, error) {
+	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
+		return client.GetBlockRewards(blockId)
+	})
+	if err != nil {
+		return beacon.BlockRewards{}, false, err
+	}
+	return result1.(beacon.BlockRewards), result2.(bool), nil
+}
+
+// Get the sync committee rewards of validators for a block
+func (m *BeaconClientManager) GetSyncCommitteeRewards(blockId string, indices []uint64) ([]beacon.SyncCommitteeReward, bool, error) {
+	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
+		return client.GetSyncCommitteeRewards(blockId, indices)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return result1.([]beacon.SyncCommitteeReward), result2.(bool), nil
+}
+
+// Subscribe to the Beacon event stream. When the stream fails it is reopened, switching between the primary and
+// fallback clients if a fallback is configured. Blocks until the context is cancelled.
+func (m *BeaconClientManager) SubscribeEvents(ctx context.Context, topics []beacon.EventTopic, handler beacon.EventHandler) error {
+
+	useFallback := false
+	for {
+		client := m.primaryBc
+		clientName := "Primary"
+		if useFallback {
+			client = m.fallbackBc
+			clientName = "Fallback"
+		}
+
+		err := client.SubscribeEvents(ctx, topics, handler)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if m.fallbackBc != nil {
+			useFallback = !useFallback
+			m.logger.Printlnf("WARNING: %s Beacon client event stream failed (%s), switching clients...", clientName, err.Error())
+		} else {
+			m.logger.Printlnf("WARNING: %s Beacon client event stream failed (%s), reconnecting...", clientName, err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(eventStreamRetryDelay):
+		}
+	}
+
+}
+
 /// ==================
 /// Internal Functions
 /// ==================
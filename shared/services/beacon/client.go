@@ -20,6 +20,8 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package beacon
 
 import (
+	"context"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/stader-labs/stader-node/stader-lib/types"
@@ -45,6 +47,14 @@ type Eth2Config struct {
 	SlotsPerEpoch                uint64
 	SecondsPerEpoch              uint64
 	EpochsPerSyncCommitteePeriod uint64
+	Forks                        []Fork
+}
+
+// A fork after genesis, as scheduled in the Beacon node's spec
+type Fork struct {
+	Name    string
+	Version []byte
+	Epoch   uint64
 }
 type Eth2DepositContract struct {
 	ChainID uint64
@@ -96,6 +106,89 @@ type AttestationInfo struct {
 	CommitteeIndex  uint64
 }
 
+// Attestation rewards of a set of validators for an epoch, in gwei
+type AttestationRewards struct {
+	IdealRewards []IdealAttestationReward
+	TotalRewards []ValidatorAttestationReward
+}
+type IdealAttestationReward struct {
+	EffectiveBalance uint64
+	Head             int64
+	Target           int64
+	Source           int64
+	InclusionDelay   int64
+	Inactivity       int64
+}
+type ValidatorAttestationReward struct {
+	ValidatorIndex uint64
+	Head           int64
+	Target         int64
+	Source         int64
+	InclusionDelay int64
+	Inactivity     int64
+}
+
+// Proposer rewards of a block, in gwei
+type BlockRewards struct {
+	ProposerIndex     uint64
+	Total             uint64
+	Attestations      uint64
+	SyncAggregate     uint64
+	ProposerSlashings uint64
+	AttesterSlashings uint64
+}
+
+// Sync committee reward of a validator for a block, in gwei
+type SyncCommitteeReward struct {
+	ValidatorIndex uint64
+	Reward         int64
+}
+
+// Beacon node event stream topics
+type EventTopic string
+
+const (
+	EventTopic_Head                EventTopic = "head"
+	EventTopic_FinalizedCheckpoint EventTopic = "finalized_checkpoint"
+	EventTopic_ChainReorg          EventTopic = "chain_reorg"
+	EventTopic_VoluntaryExit       EventTopic = "voluntary_exit"
+)
+
+type HeadEvent struct {
+	Slot            uint64
+	Block           common.Hash
+	State           common.Hash
+	EpochTransition bool
+}
+type FinalizedCheckpointEvent struct {
+	Epoch uint64
+	Block common.Hash
+	State common.Hash
+}
+type ChainReorgEvent struct {
+	Slot         uint64
+	Depth        uint64
+	Epoch        uint64
+	OldHeadBlock common.Hash
+	NewHeadBlock common.Hash
+}
+type VoluntaryExitEvent struct {
+	ValidatorIndex uint64
+	Epoch          uint64
+}
+
+// An event from the Beacon node's event stream, only the field matching the topic is set
+type Event struct {
+	Topic               EventTopic
+	Head                *HeadEvent
+	FinalizedCheckpoint *FinalizedCheckpointEvent
+	ChainReorg          *ChainReorgEvent
+	VoluntaryExit       *VoluntaryExitEvent
+}
+
+// Called for every event received on a subscription
+type EventHandler func(event Event)
+
 // Beacon client type
 type BeaconClientType int
 
@@ -148,4 +241,8 @@ type Client interface {
 	Close() error
 	GetEth1DataForEth2Block(blockId string) (Eth1Data, bool, error)
 	GetCommitteesForEpoch(epoch *uint64) ([]Committee, error)
+	GetAttestationRewards(epoch uint64, indices []uint64) (AttestationRewards, error)
+	GetBlockRewards(blockId string) (BlockRewards, bool, error)
+	GetSyncCommitteeRewards(blockId string, indices []uint64) ([]SyncCommitteeReward, bool, error)
+	SubscribeEvents(ctx context.Context, topics []EventTopic, handler EventHandler) error
 }
@@ -20,11 +20,14 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package client
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"strconv"
 	"strings"
@@ -59,9 +62,16 @@ const (
 	RequestBeaconBlockPath           = "/eth/v2/beacon/blocks/%s"
 	RequestValidatorSyncDuties       = "/eth/v1/validator/duties/sync/%s"
 	RequestValidatorProposerDuties   = "/eth/v1/validator/duties/proposer/%s"
+	RequestEventsPath                = "/eth/v1/events?topics=%s"
+	RequestAttestationRewardsPath    = "/eth/v1/beacon/rewards/attestations/%d"
+	RequestBlockRewardsPath          = "/eth/v1/beacon/rewards/blocks/%s"
+	RequestSyncCommitteeRewardsPath  = "/eth/v1/beacon/rewards/sync_committee/%s"
 
 	MaxRequestValidatorsCount     = 600
 	threadLimit               int = 6
+
+	// Largest event line accepted on the event stream
+	maxEventLineSize = 1024 * 1024
 )
 
 // Beacon client using the standard Beacon HTTP REST API (https://ethereum.github.io/beacon-APIs/)
@@ -133,6 +143,19 @@ func (c *StandardHttpClient) GetEth2Config() (beacon.Eth2Config, error) {
 		return beacon.Eth2Config{}, err
 	}
 
+	// Forks the Beacon node doesn't know about are left out
+	forks := []beacon.Fork{}
+	for _, fork := range []beacon.Fork{
+		{Name: "altair", Version: eth2Config.Data.AltairForkVersion, Epoch: uint64(eth2Config.Data.AltairForkEpoch)},
+		{Name: "bellatrix", Version: eth2Config.Data.BellatrixForkVersion, Epoch: uint64(eth2Config.Data.BellatrixForkEpoch)},
+		{Name: "capella", Version: eth2Config.Data.CapellaForkVersion, Epoch: uint64(eth2Config.Data.CapellaForkEpoch)},
+		{Name: "deneb", Version: eth2Config.Data.DenebForkVersion, Epoch: uint64(eth2Config.Data.DenebForkEpoch)},
+	} {
+		if len(fork.Version) > 0 {
+			forks = append(forks, fork)
+		}
+	}
+
 	// Return response
 	return beacon.Eth2Config{
 		GenesisForkVersion:           genesis.Data.GenesisForkVersion,
@@ -143,6 +166,7 @@ func (c *StandardHttpClient) GetEth2Config() (beacon.Eth2Config, error) {
 		SlotsPerEpoch:                uint64(eth2Config.Data.SlotsPerEpoch),
 		SecondsPerEpoch:              uint64(eth2Config.Data.SecondsPerSlot * eth2Config.Data.SlotsPerEpoch),
 		EpochsPerSyncCommitteePeriod: uint64(eth2Config.Data.EpochsPerSyncCommitteePeriod),
+		Forks:                        forks,
 	}, nil
 
 }
@@ -571,6 +595,174 @@ func (c *StandardHttpClient) GetCommitteesForEpoch(epoch *uint64) ([]beacon.Comm
 	return committees, nil
 }
 
+// Get the attestation rewards of validators for an epoch
+func (c *StandardHttpClient) GetAttestationRewards(epoch uint64, indices []uint64) (beacon.AttestationRewards, error) {
+	responseBody, status, err := c.postRequest(fmt.Sprintf(RequestAttestationRewardsPath, epoch), indicesToStrings(indices))
+	if err != nil {
+		return beacon.AttestationRewards{}, fmt.Errorf("Could not get attestation rewards for epoch %d: %w", epoch, err)
+	}
+	if status != http.StatusOK {
+		return beacon.AttestationRewards{}, fmt.Errorf("Could not get attestation rewards for epoch %d: HTTP status %d; response body: '%s'", epoch, status, string(responseBody))
+	}
+	var response AttestationRewardsResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return beacon.AttestationRewards{}, fmt.Errorf("Could not decode attestation rewards data: %w", err)
+	}
+
+	rewards := beacon.AttestationRewards{
+		IdealRewards: make([]beacon.IdealAttestationReward, len(response.Data.IdealRewards)),
+		TotalRewards: make([]beacon.ValidatorAttestationReward, len(response.Data.TotalRewards)),
+	}
+	for i, ideal := range response.Data.IdealRewards {
+		rewards.IdealRewards[i] = beacon.IdealAttestationReward{
+			EffectiveBalance: uint64(ideal.EffectiveBalance),
+			Head:             int64(ideal.Head),
+			Target:           int64(ideal.Target),
+			Source:           int64(ideal.Source),
+			InclusionDelay:   int64(ideal.InclusionDelay),
+			Inactivity:       int64(ideal.Inactivity),
+		}
+	}
+	for i, total := range response.Data.TotalRewards {
+		rewards.TotalRewards[i] = beacon.ValidatorAttestationReward{
+			ValidatorIndex: uint64(total.ValidatorIndex),
+			Head:           int64(total.Head),
+			Target:         int64(total.Target),
+			Source:         int64(total.Source),
+			InclusionDelay: int64(total.InclusionDelay),
+			Inactivity:     int64(total.Inactivity),
+		}
+	}
+	return rewards, nil
+}
+
+// Get the proposer rewards of a block, returns false if there is no block for the id
+func (c *StandardHttpClient) GetBlockRewards(blockId string) (beacon.BlockRewards, bool, error) {
+	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestBlockRewardsPath, blockId))
+	if err != nil {
+		return beacon.BlockRewards{}, false, fmt.Errorf("Could not get block rewards for block %s: %w", blockId, err)
+	}
+	if status == http.StatusNotFound {
+		return beacon.BlockRewards{}, false, nil
+	}
+	if status != http.StatusOK {
+		return beacon.BlockRewards{}, false, fmt.Errorf("Could not get block rewards for block %s: HTTP status %d; response body: '%s'", blockId, status, string(responseBody))
+	}
+	var response BlockRewardsResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return beacon.BlockRewards{}, false, fmt.Errorf("Could not decode block rewards data: %w", err)
+	}
+
+	return beacon.BlockRewards{
+		ProposerIndex:     uint64(response.Data.ProposerIndex),
+		Total:             uint64(response.Data.Total),
+		Attestations:      uint64(response.Data.Attestations),
+		SyncAggregate:     uint64(response.Data.SyncAggregate),
+		ProposerSlashings: uint64(response.Data.ProposerSlashings),
+		AttesterSlashings: uint64(response.Data.AttesterSlashings),
+	}, true, nil
+}
+
+// Get the sync committee rewards of validators for a block, returns false if there is no block for the id.
+// Only the validators that were in the sync committee are returned.
+func (c *StandardHttpClient) GetSyncCommitteeRewards(blockId string, indices []uint64) ([]beacon.SyncCommitteeReward, bool, error) {
+	responseBody, status, err := c.postRequest(fmt.Sprintf(RequestSyncCommitteeRewardsPath, blockId), indicesToStrings(indices))
+	if err != nil {
+		return nil, false, fmt.Errorf("Could not get sync committee rewards for block %s: %w", blockId, err)
+	}
+	if status == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if status != http.StatusOK {
+		return nil, false, fmt.Errorf("Could not get sync committee rewards for block %s: HTTP status %d; response body: '%s'", blockId, status, string(responseBody))
+	}
+	var response SyncCommitteeRewardsResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, false, fmt.Errorf("Could not decode sync committee rewards data: %w", err)
+	}
+
+	rewards := make([]beacon.SyncCommitteeReward, len(response.Data))
+	for i, reward := range response.Data {
+		rewards[i] = beacon.SyncCommitteeReward{
+			ValidatorIndex: uint64(reward.ValidatorIndex),
+			Reward:         int64(reward.Reward),
+		}
+	}
+	return rewards, true, nil
+}
+
+// Subscribe to the Beacon node's event stream, calling the handler for each event on the given topics.
+// Blocks until the context is cancelled or the stream fails.
+func (c *StandardHttpClient) SubscribeEvents(ctx context.Context, topics []beacon.EventTopic, handler beacon.EventHandler) error {
+
+	if len(topics) == 0 {
+		return fmt.Errorf("no event topics to subscribe to")
+	}
+	topicNames := make([]string, len(topics))
+	for i, topic := range topics {
+		topicNames[i] = string(topic)
+	}
+
+	// Open the stream
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(RequestUrlFormat, c.providerAddress, fmt.Sprintf(RequestEventsPath, strings.Join(topicNames, ","))), nil)
+	if err != nil {
+		return fmt.Errorf("Could not create event stream request: %w", err)
+	}
+	request.Header.Set("Accept", "text/event-stream")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("Could not open event stream: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	if response.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(response.Body)
+		return fmt.Errorf("Could not open event stream: HTTP status %d; response body: '%s'", response.StatusCode, string(body))
+	}
+
+	// Read events, each one is an "event:" line and "data:" lines ended by a blank line
+	scanner := bufio.NewScanner(response.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventLineSize)
+	var eventName string
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if eventName != "" && data.Len() > 0 {
+				// A single bad event doesn't warrant reopening the stream
+				event, err := parseEvent(beacon.EventTopic(eventName), []byte(data.String()))
+				if err != nil {
+					log.Printf("WARNING: Skipping %s event from the Beacon node: %s\n", eventName, err.Error())
+				} else {
+					handler(event)
+				}
+			}
+			eventName = ""
+			data.Reset()
+		case strings.HasPrefix(line, ":"):
+			// Comment, used by some clients as a keep-alive
+		case strings.HasPrefix(line, "event:"):
+			eventName = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteString("\n")
+			}
+			data.WriteString(strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("Error reading event stream: %w", err)
+	}
+	return fmt.Errorf("Event stream closed by the Beacon node")
+
+}
+
 // Get sync status
 func (c *StandardHttpClient) getSyncStatus() (SyncStatusResponse, error) {
 	responseBody, status, err := c.getRequest(RequestSyncStatusPath)
@@ -824,6 +1016,65 @@ func (c *StandardHttpClient) getCommittees(stateId string, epoch *uint64) (Commi
 	return committees, nil
 }
 
+// Convert validator indices to the strings expected in request bodies
+func indicesToStrings(indices []uint64) []string {
+	indicesStrings := make([]string, len(indices))
+	for i, index := range indices {
+		indicesStrings[i] = strconv.FormatUint(index, 10)
+	}
+	return indicesStrings
+}
+
+// Decode the data of an event stream event
+func parseEvent(topic beacon.EventTopic, data []byte) (beacon.Event, error) {
+	event := beacon.Event{Topic: topic}
+	switch topic {
+	case beacon.EventTopic_Head:
+		var head HeadEventData
+		if err := json.Unmarshal(data, &head); err != nil {
+			return beacon.Event{}, fmt.Errorf("Could not decode head event: %w", err)
+		}
+		event.Head = &beacon.HeadEvent{
+			Slot:            uint64(head.Slot),
+			Block:           head.Block,
+			State:           head.State,
+			EpochTransition: head.EpochTransition,
+		}
+	case beacon.EventTopic_FinalizedCheckpoint:
+		var checkpoint FinalizedCheckpointEventData
+		if err := json.Unmarshal(data, &checkpoint); err != nil {
+			return beacon.Event{}, fmt.Errorf("Could not decode finalized checkpoint event: %w", err)
+		}
+		event.FinalizedCheckpoint = &beacon.FinalizedCheckpointEvent{
+			Epoch: uint64(checkpoint.Epoch),
+			Block: checkpoint.Block,
+			State: checkpoint.State,
+		}
+	case beacon.EventTopic_ChainReorg:
+		var reorg ChainReorgEventData
+		if err := json.Unmarshal(data, &reorg); err != nil {
+			return beacon.Event{}, fmt.Errorf("Could not decode chain reorg event: %w", err)
+		}
+		event.ChainReorg = &beacon.ChainReorgEvent{
+			Slot:         uint64(reorg.Slot),
+			Depth:        uint64(reorg.Depth),
+			Epoch:        uint64(reorg.Epoch),
+			OldHeadBlock: reorg.OldHeadBlock,
+			NewHeadBlock: reorg.NewHeadBlock,
+		}
+	case beacon.EventTopic_VoluntaryExit:
+		var exit VoluntaryExitEventData
+		if err := json.Unmarshal(data, &exit); err != nil {
+			return beacon.Event{}, fmt.Errorf("Could not decode voluntary exit event: %w", err)
+		}
+		event.VoluntaryExit = &beacon.VoluntaryExitEvent{
+			ValidatorIndex: uint64(exit.Message.ValidatorIndex),
+			Epoch:          uint64(exit.Message.Epoch),
+		}
+	}
+	return event, nil
+}
+
 // Make a GET request to the beacon node
 func (c *StandardHttpClient) getRequest(requestPath string) ([]byte, int, error) {
 
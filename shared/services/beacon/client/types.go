@@ -48,9 +48,17 @@ type SyncStatusResponse struct {
 }
 type Eth2ConfigResponse struct {
 	Data struct {
-		SecondsPerSlot               uinteger `json:"SECONDS_PER_SLOT"`
-		SlotsPerEpoch                uinteger `json:"SLOTS_PER_EPOCH"`
-		EpochsPerSyncCommitteePeriod uinteger `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+		SecondsPerSlot               uinteger  `json:"SECONDS_PER_SLOT"`
+		SlotsPerEpoch                uinteger  `json:"SLOTS_PER_EPOCH"`
+		EpochsPerSyncCommitteePeriod uinteger  `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+		AltairForkVersion            byteArray `json:"ALTAIR_FORK_VERSION"`
+		AltairForkEpoch              uinteger  `json:"ALTAIR_FORK_EPOCH"`
+		BellatrixForkVersion         byteArray `json:"BELLATRIX_FORK_VERSION"`
+		BellatrixForkEpoch           uinteger  `json:"BELLATRIX_FORK_EPOCH"`
+		CapellaForkVersion           byteArray `json:"CAPELLA_FORK_VERSION"`
+		CapellaForkEpoch             uinteger  `json:"CAPELLA_FORK_EPOCH"`
+		DenebForkVersion             byteArray `json:"DENEB_FORK_VERSION"`
+		DenebForkEpoch               uinteger  `json:"DENEB_FORK_EPOCH"`
 	} `json:"data"`
 }
 type Eth2DepositContractResponse struct {
@@ -160,6 +168,66 @@ type Attestation struct {
 	} `json:"data"`
 }
 
+type AttestationRewardsResponse struct {
+	Data struct {
+		IdealRewards []struct {
+			EffectiveBalance uinteger `json:"effective_balance"`
+			Head             sinteger `json:"head"`
+			Target           sinteger `json:"target"`
+			Source           sinteger `json:"source"`
+			InclusionDelay   sinteger `json:"inclusion_delay"`
+			Inactivity       sinteger `json:"inactivity"`
+		} `json:"ideal_rewards"`
+		TotalRewards []struct {
+			ValidatorIndex uinteger `json:"validator_index"`
+			Head           sinteger `json:"head"`
+			Target         sinteger `json:"target"`
+			Source         sinteger `json:"source"`
+			InclusionDelay sinteger `json:"inclusion_delay"`
+			Inactivity     sinteger `json:"inactivity"`
+		} `json:"total_rewards"`
+	} `json:"data"`
+}
+type BlockRewardsResponse struct {
+	Data struct {
+		ProposerIndex     uinteger `json:"proposer_index"`
+		Total             uinteger `json:"total"`
+		Attestations      uinteger `json:"attestations"`
+		SyncAggregate     uinteger `json:"sync_aggregate"`
+		ProposerSlashings uinteger `json:"proposer_slashings"`
+		AttesterSlashings uinteger `json:"attester_slashings"`
+	} `json:"data"`
+}
+type SyncCommitteeRewardsResponse struct {
+	Data []struct {
+		ValidatorIndex uinteger `json:"validator_index"`
+		Reward         sinteger `json:"reward"`
+	} `json:"data"`
+}
+
+type HeadEventData struct {
+	Slot            uinteger    `json:"slot"`
+	Block           common.Hash `json:"block"`
+	State           common.Hash `json:"state"`
+	EpochTransition bool        `json:"epoch_transition"`
+}
+type FinalizedCheckpointEventData struct {
+	Epoch uinteger    `json:"epoch"`
+	Block common.Hash `json:"block"`
+	State common.Hash `json:"state"`
+}
+type ChainReorgEventData struct {
+	Slot         uinteger    `json:"slot"`
+	Depth        uinteger    `json:"depth"`
+	Epoch        uinteger    `json:"epoch"`
+	OldHeadBlock common.Hash `json:"old_head_block"`
+	NewHeadBlock common.Hash `json:"new_head_block"`
+}
+type VoluntaryExitEventData struct {
+	Message   VoluntaryExitMessage `json:"message"`
+	Signature byteArray            `json:"signature"`
+}
+
 // Unsigned integer type
 type uinteger uint64
 
@@ -186,6 +254,32 @@ func (i *uinteger) UnmarshalJSON(data []byte) error {
 
 }
 
+// Signed integer type
+type sinteger int64
+
+func (i sinteger) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatInt(int64(i), 10))
+}
+func (i *sinteger) UnmarshalJSON(data []byte) error {
+
+	// Unmarshal string
+	var dataStr string
+	if err := json.Unmarshal(data, &dataStr); err != nil {
+		return err
+	}
+
+	// Parse integer value
+	value, err := strconv.ParseInt(dataStr, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	// Set value and return
+	*i = sinteger(value)
+	return nil
+
+}
+
 // Byte array type
 type byteArray []byte
 
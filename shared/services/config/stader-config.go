@@ -29,6 +29,7 @@ import (
 	"strconv"
 
 	"github.com/alessio/shellescape"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pbnjay/memory"
 	"github.com/stader-labs/stader-node/shared"
 	"github.com/stader-labs/stader-node/shared/types/config"
@@ -62,6 +63,10 @@ const defaultVcMetricsPort uint16 = 9101
 const defaultNodeMetricsPort uint16 = 9104
 const defaultExporterMetricsPort uint16 = 9103
 const defaultEcMetricsPort uint16 = 9105
+const defaultEnableKeymanagerApi bool = false
+const defaultKeymanagerApiPort uint16 = 5062
+const defaultEnableWeb3Signer bool = false
+const defaultWeb3SignerImportKeys bool = true
 
 // The master configuration struct
 type StaderConfig struct {
@@ -96,6 +101,15 @@ type StaderConfig struct {
 	ExporterMetricsPort     config.Parameter `yaml:"exporterMetricsPort,omitempty"`
 	EnableBitflyNodeMetrics config.Parameter `yaml:"enableBitflyNodeMetrics,omitempty"`
 
+	// Validator client Keymanager API settings
+	EnableKeymanagerApi config.Parameter `yaml:"enableKeymanagerApi,omitempty"`
+	KeymanagerApiPort   config.Parameter `yaml:"keymanagerApiPort,omitempty"`
+
+	// Remote signer settings
+	EnableWeb3Signer     config.Parameter `yaml:"enableWeb3Signer,omitempty"`
+	Web3SignerUrl        config.Parameter `yaml:"web3SignerUrl,omitempty"`
+	Web3SignerImportKeys config.Parameter `yaml:"web3SignerImportKeys,omitempty"`
+
 	// The StaderNode configuration
 	StaderNode *StaderNodeConfig `yaml:"stadernode,omitempty"`
 
@@ -391,6 +405,67 @@ func NewStaderConfig(staderDir string, isNativeMode bool) *StaderConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		EnableKeymanagerApi: config.Parameter{
+			ID:                   "enableKeymanagerApi",
+			Name:                 "Enable Keymanager API",
+			Description:          "Enable the Keymanager API of your validator client, so the node daemon can update its fee recipient without restarting it.\nIf disabled, or if the API can't be reached, the validator client is restarted with the new fee recipient instead.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultEnableKeymanagerApi},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Validator, config.ContainerID_Node},
+			EnvironmentVariables: []string{"ENABLE_KEYMANAGER_API"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		KeymanagerApiPort: config.Parameter{
+			ID:                   "keymanagerApiPort",
+			Name:                 "Keymanager API Port",
+			Description:          "The port your validator client should run its Keymanager API on. It is only reachable from inside Docker.",
+			Type:                 config.ParameterType_Uint16,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultKeymanagerApiPort},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Validator, config.ContainerID_Node},
+			EnvironmentVariables: []string{"KEYMANAGER_API_PORT"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		EnableWeb3Signer: config.Parameter{
+			ID:   "enableWeb3Signer",
+			Name: "Use Web3Signer",
+			Description: "Keep your validator keys in a Web3Signer remote signer instead of the validator client folder. The validator client and the presigned exit messages are signed by the Web3Signer, so the node never holds the keys after creating them.\n" +
+				"Keys already stored in the validator client folder are not moved; remove them before enabling this so a key is never loaded by two signers.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultEnableWeb3Signer},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator},
+			EnvironmentVariables: []string{"ENABLE_WEB3SIGNER"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		Web3SignerUrl: config.Parameter{
+			ID:                   "web3SignerUrl",
+			Name:                 "Web3Signer URL",
+			Description:          "The URL of your Web3Signer, for example http://web3signer:9000. It must be reachable from the validator client and the node containers.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator},
+			EnvironmentVariables: []string{"WEB3SIGNER_URL"},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		Web3SignerImportKeys: config.Parameter{
+			ID:                   "web3SignerImportKeys",
+			Name:                 "Import Keys into Web3Signer",
+			Description:          "Import new validator keys into the Web3Signer through its Keymanager API. Its Keymanager API must be enabled.\nDisable this if you load the keys into the Web3Signer yourself; the node then only checks that they are there.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultWeb3SignerImportKeys},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		NodeMetricsPort: config.Parameter{
 			ID:                   "nodeMetricsPort",
 			Name:                 "Node Metrics Port",
@@ -522,6 +597,11 @@ func (cfg *StaderConfig) GetParameters() []*config.Parameter {
 		&cfg.VcMetricsPort,
 		&cfg.NodeMetricsPort,
 		&cfg.ExporterMetricsPort,
+		&cfg.EnableKeymanagerApi,
+		&cfg.KeymanagerApiPort,
+		&cfg.EnableWeb3Signer,
+		&cfg.Web3SignerUrl,
+		&cfg.Web3SignerImportKeys,
 		&cfg.EnableMevBoost,
 	}
 }
@@ -668,6 +748,31 @@ func (cfg *StaderConfig) GetSelectedConsensusClientConfig() (config.ConsensusCon
 	}
 }
 
+// Get the URL of the validator client's Keymanager API, and the path of its API token file relative to the validator keychain folder
+func (cfg *StaderConfig) GetKeymanagerApiInfo() (string, string, error) {
+	if cfg.IsNativeMode {
+		return "", "", fmt.Errorf("the Keymanager API is not available in native mode")
+	}
+
+	var tokenPath string
+	client, _ := cfg.GetSelectedConsensusClient()
+	switch client {
+	case config.ConsensusClient_Lighthouse:
+		tokenPath = filepath.Join("lighthouse", "validators", "api-token.txt")
+	case config.ConsensusClient_Nimbus:
+		tokenPath = filepath.Join("nimbus", "keymanager-token.txt")
+	case config.ConsensusClient_Prysm:
+		tokenPath = filepath.Join("prysm-non-hd", "auth-token")
+	case config.ConsensusClient_Teku:
+		tokenPath = filepath.Join("teku", "validator", "key-manager", "validator-api-bearer")
+	default:
+		return "", "", fmt.Errorf("the Keymanager API is not supported for consensus client [%v]", client)
+	}
+
+	apiUrl := fmt.Sprintf("http://%s:%d", ValidatorContainerName, cfg.KeymanagerApiPort.Value)
+	return apiUrl, tokenPath, nil
+}
+
 // Check if doppelganger protection is enabled
 func (cfg *StaderConfig) IsDoppelgangerEnabled() (bool, error) {
 	if cfg.IsNativeMode {
@@ -809,6 +914,13 @@ func (cfg *StaderConfig) GenerateEnvironmentVariables() map[string]string {
 	envVars["TX_FEE_CAP"] = fmt.Sprintf("%d", int64(txFeeCap))
 	envVars["TX_FEE_CAP_IN_GWEI"] = fmt.Sprintf("%d", int64(txFeeCapInGwei))
 	config.AddParametersToEnvVars(cfg.StaderNode.GetParameters(), envVars)
+
+	// Publish the node daemon's health server on the host's loopback interface for local orchestration
+	if cfg.StaderNode.EnableHealthServer.Value == true {
+		port := cfg.StaderNode.HealthServerPort.Value.(uint16)
+		envVars["NODE_HEALTH_OPEN_PORT"] = fmt.Sprintf("\"127.0.0.1:%d:%d/tcp\"", port, port)
+	}
+
 	config.AddParametersToEnvVars(cfg.GetParameters(), envVars)
 
 	// EC parameters
@@ -1085,6 +1197,27 @@ func (cfg *StaderConfig) Validate() []string {
 		}
 	}
 
+	// Ensure the external node account signer can be reached and checked
+	if signerType := cfg.StaderNode.NodeSignerType.Value.(config.NodeSignerType); signerType != config.NodeSignerType_Local {
+		if signerType != config.NodeSignerType_Offline && cfg.StaderNode.NodeSignerUrl.Value.(string) == "" {
+			errors = append(errors, "You have an external node account signer selected but don't have its URL set. Please enter the signer URL to use it.")
+		}
+		if !common.IsHexAddress(cfg.StaderNode.NodeSignerAddress.Value.(string)) {
+			errors = append(errors, "You have an external node account signer selected but don't have a valid node account address set. Please enter the address of the account the signer holds.")
+		}
+	}
+
+	// Ensure there's a Web3Signer URL, and that Lighthouse can be given the Web3Signer keys
+	if !cfg.IsNativeMode && cfg.EnableWeb3Signer.Value == true {
+		if cfg.Web3SignerUrl.Value.(string) == "" {
+			errors = append(errors, "You have Web3Signer enabled but don't have a URL set. Please enter the Web3Signer URL to use it.")
+		}
+		client, _ := cfg.GetSelectedConsensusClient()
+		if client == config.ConsensusClient_Lighthouse && cfg.EnableKeymanagerApi.Value != true {
+			errors = append(errors, "Lighthouse loads Web3Signer keys through its Keymanager API. Please enable the Keymanager API to use Web3Signer with Lighthouse.")
+		}
+	}
+
 	return errors
 }
 
@@ -27,6 +27,7 @@ import (
 	"math/big"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mitchellh/go-homedir"
@@ -43,11 +44,18 @@ const (
 	NetworkID                   string = "network"
 	ProjectNameID               string = "projectName"
 	DaemonDataPath              string = "/.stader/data"
+	WalletFolder                string = "masterami"
 	GuardianFolder              string = "guardian"
 	SpRewardsMerkleProofsFolder string = "sp-rewards-merkle-proofs"
 	MerkleProofsFormat          string = "cycle-%s-%d.json"
+	QuarantineFolder            string = "quarantine"
 	FeeRecipientFilename        string = "stader-fee-recipient.txt"
 	NativeFeeRecipientFilename  string = "stader-fee-recipient-env.txt"
+	PresignLedgerFilename       string = "presign-ledger.json"
+	PresignedExitsFilename      string = "presigned-exits.json"
+	SlashingProtectionFolder    string = "slashing-protection"
+	TransactionsFilename        string = "transactions.json"
+	EventIndexFolder            string = "event-index"
 )
 
 //go:embed prod-presign-public-key.txt
@@ -62,6 +70,8 @@ var devEncryptionKey string
 // --ignore-sync-check
 // Defaults
 const defaultProjectName string = "stader"
+const defaultHealthServerPort uint16 = 9106
+const defaultHealthCheckWindow uint64 = 30
 
 // Configuration for the Stader node
 type StaderNodeConfig struct {
@@ -95,9 +105,69 @@ type StaderNodeConfig struct {
 	// Max tx fee for a single tx override
 	TxFeeCap config.Parameter `yaml:"txFeeCap,omitempty"`
 
+	// Toggle for comparing gas prices with, and falling back to, Etherchain and Etherscan
+	UseExternalGasOracles config.Parameter `yaml:"useExternalGasOracles,omitempty"`
+
 	// URL for an EC with archive mode, for manual rewards tree generation
 	ArchiveECUrl config.Parameter `yaml:"archiveEcUrl,omitempty"`
 
+	// Toggle for the node daemon's health and readiness server
+	EnableHealthServer config.Parameter `yaml:"enableHealthServer,omitempty"`
+
+	// The port the node daemon's health and readiness server listens on
+	HealthServerPort config.Parameter `yaml:"healthServerPort,omitempty"`
+
+	// How long a daemon task may go past its interval without succeeding before the daemon is unhealthy
+	HealthCheckWindow config.Parameter `yaml:"healthCheckWindow,omitempty"`
+
+	// Toggle for automatically claiming socializing pool rewards
+	EnableAutoClaimSpRewards config.Parameter `yaml:"enableAutoClaimSpRewards,omitempty"`
+
+	// The minimum value of claimable rewards, in ETH, before they are automatically claimed
+	AutoClaimSpRewardsMinimum config.Parameter `yaml:"autoClaimSpRewardsMinimum,omitempty"`
+
+	// The highest base fee, in gwei, at which rewards are automatically claimed
+	AutoClaimSpRewardsMaxBaseFee config.Parameter `yaml:"autoClaimSpRewardsMaxBaseFee,omitempty"`
+
+	// What the node daemon does when the EL vault or operator rewards collector is due to be swept
+	AutoSweepMode config.Parameter `yaml:"autoSweepMode,omitempty"`
+
+	// The operator share of the EL vault, in ETH, above which it is swept
+	AutoSweepElVaultThreshold config.Parameter `yaml:"autoSweepElVaultThreshold,omitempty"`
+
+	// The operator rewards collector balance, in ETH, above which it is claimed
+	AutoSweepRewardsCollectorThreshold config.Parameter `yaml:"autoSweepRewardsCollectorThreshold,omitempty"`
+
+	// The highest base fee, in gwei, at which rewards are swept
+	AutoSweepMaxBaseFee config.Parameter `yaml:"autoSweepMaxBaseFee,omitempty"`
+
+	// Toggle for automatically distributing the CL rewards of validator withdraw vaults
+	EnableAutoSendClRewards config.Parameter `yaml:"enableAutoSendClRewards,omitempty"`
+
+	// The minimum operator share, in ETH, a withdraw vault must hold before its CL rewards are distributed
+	AutoSendClRewardsMinimum config.Parameter `yaml:"autoSendClRewardsMinimum,omitempty"`
+
+	// The highest base fee, in gwei, at which CL rewards are distributed
+	AutoSendClRewardsMaxBaseFee config.Parameter `yaml:"autoSendClRewardsMaxBaseFee,omitempty"`
+
+	// Toggle for indexing the contract events related to the operator into a local database
+	EnableEventIndexer config.Parameter `yaml:"enableEventIndexer,omitempty"`
+
+	// Toggle for taking the metrics snapshot at the finalized slot instead of the head slot
+	UseFinalizedMetricsSnapshot config.Parameter `yaml:"useFinalizedMetricsSnapshot,omitempty"`
+
+	// Toggle for keeping a local plaintext copy of the presigned exit messages
+	StorePresignedExits config.Parameter `yaml:"storePresignedExits,omitempty"`
+
+	// What signs transactions and messages for the node account
+	NodeSignerType config.Parameter `yaml:"nodeSignerType,omitempty"`
+
+	// The endpoint of the external node account signer
+	NodeSignerUrl config.Parameter `yaml:"nodeSignerUrl,omitempty"`
+
+	// The node account address held by the external signer
+	NodeSignerAddress config.Parameter `yaml:"nodeSignerAddress,omitempty"`
+
 	///////////////////////////
 	// Non-editable settings //
 	///////////////////////////
@@ -120,6 +190,9 @@ type StaderNodeConfig struct {
 	// The contract address of stader config
 	staderConfigAddress map[config.Network]string `yaml:"-"`
 
+	// The address of Multicall3, blank where it isn't deployed
+	multicall3Address map[config.Network]string `yaml:"-"`
+
 	// The base url of stader backend
 	baseStaderBackendUrl map[config.Network]string `yaml:"-"`
 
@@ -219,6 +292,18 @@ func NewStadernodeConfig(cfg *StaderConfig) *StaderNodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		UseExternalGasOracles: config.Parameter{
+			ID:                   "useExternalGasOracles",
+			Name:                 "Use External Gas Oracles",
+			Description:          "Gas price suggestions come from the fee history of recent blocks, read from your Execution client.\n\nEnable this to also show the suggestions of Etherchain and Etherscan for comparison, and to fall back to them if your Execution client can't provide a fee history. Their suggestions are for Mainnet only.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_Mainnet: true, config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		ArchiveECUrl: config.Parameter{
 			ID:                   "archiveECUrl",
 			Name:                 "Archive-Mode EC URL",
@@ -231,6 +316,268 @@ func NewStadernodeConfig(cfg *StaderConfig) *StaderNodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		EnableHealthServer: config.Parameter{
+			ID:                   "enableHealthServer",
+			Name:                 "Enable Health Server",
+			Description:          "Enable the `/healthz` and `/readyz` HTTP endpoints of the node daemon, so your orchestration can probe whether the daemon's tasks are running and its clients are synced.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{"ENABLE_NODE_HEALTH_SERVER"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		HealthServerPort: config.Parameter{
+			ID:                   "healthServerPort",
+			Name:                 "Health Server Port",
+			Description:          "The port the node daemon's health and readiness endpoints should be served on.",
+			Type:                 config.ParameterType_Uint16,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultHealthServerPort},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{"NODE_HEALTH_PORT"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		HealthCheckWindow: config.Parameter{
+			ID:                   "healthCheckWindow",
+			Name:                 "Health Check Window",
+			Description:          "How many minutes a node daemon task (presign, fee recipient, merkle proofs) may go past its regular interval without a successful run before `/healthz` reports the daemon as unhealthy.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultHealthCheckWindow},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		EnableAutoClaimSpRewards: config.Parameter{
+			ID:                   "enableAutoClaimSpRewards",
+			Name:                 "Auto Claim SP Rewards",
+			Description:          "Let the node daemon claim your unclaimed socializing pool rewards automatically, in a single transaction, once they are worth claiming and gas is cheap enough.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoClaimSpRewardsMinimum: config.Parameter{
+			ID:                   "autoClaimSpRewardsMinimum",
+			Name:                 "Auto Claim Minimum",
+			Description:          "The minimum value (in ETH) of your claimable socializing pool rewards before they are claimed automatically. SD rewards are converted to ETH at the current SD price.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0.1)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoClaimSpRewardsMaxBaseFee: config.Parameter{
+			ID:                   "autoClaimSpRewardsMaxBaseFee",
+			Name:                 "Auto Claim Max Base Fee",
+			Description:          "The highest network base fee (in gwei) at which socializing pool rewards are claimed automatically. The claim is postponed while the base fee is above this value.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(30)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoSweepMode: config.Parameter{
+			ID:                   "autoSweepMode",
+			Name:                 "Auto Sweep Rewards",
+			Description:          "Choose what the node daemon does when your EL reward vault or operator rewards collector balance goes above its threshold.",
+			Type:                 config.ParameterType_Choice,
+			Default:              map[config.Network]interface{}{config.Network_All: config.AutoSweepMode_Disabled},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+			Options: []config.ParameterOption{{
+				Name:        "Disabled",
+				Description: "Don't watch the reward balances.",
+				Value:       config.AutoSweepMode_Disabled,
+			}, {
+				Name:        "Notify",
+				Description: "Only log when the rewards are due to be swept.",
+				Value:       config.AutoSweepMode_Notify,
+			}, {
+				Name:        "Dry Run",
+				Description: "Log the transactions that would be sent, with their gas estimates, without sending them.",
+				Value:       config.AutoSweepMode_DryRun,
+			}, {
+				Name:        "Enabled",
+				Description: "Send the EL vault withdrawal and the rewards claim to your operator reward address.",
+				Value:       config.AutoSweepMode_Enabled,
+			}},
+		},
+
+		AutoSweepElVaultThreshold: config.Parameter{
+			ID:                   "autoSweepElVaultThreshold",
+			Name:                 "EL Vault Sweep Threshold",
+			Description:          "Your share (in ETH) of your EL reward vault balance above which it is swept.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0.1)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoSweepRewardsCollectorThreshold: config.Parameter{
+			ID:                   "autoSweepRewardsCollectorThreshold",
+			Name:                 "Rewards Collector Sweep Threshold",
+			Description:          "Your operator rewards collector balance (in ETH) above which it is claimed to your operator reward address.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0.1)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoSweepMaxBaseFee: config.Parameter{
+			ID:                   "autoSweepMaxBaseFee",
+			Name:                 "Sweep Max Base Fee",
+			Description:          "The highest network base fee (in gwei) at which rewards are swept. The sweep is postponed while the base fee is above this value.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(30)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		EnableAutoSendClRewards: config.Parameter{
+			ID:                   "enableAutoSendClRewards",
+			Name:                 "Auto Send CL Rewards",
+			Description:          "Let the node daemon send the CL rewards of your validators' withdraw vaults to your claim vault automatically, once a vault holds enough rewards to be worth the gas.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoSendClRewardsMinimum: config.Parameter{
+			ID:                   "autoSendClRewardsMinimum",
+			Name:                 "CL Rewards Minimum",
+			Description:          "The minimum operator share (in ETH) a withdraw vault must hold before its CL rewards are sent automatically.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0.05)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoSendClRewardsMaxBaseFee: config.Parameter{
+			ID:                   "autoSendClRewardsMaxBaseFee",
+			Name:                 "CL Rewards Max Base Fee",
+			Description:          "The highest network base fee (in gwei) at which CL rewards are sent automatically. Sending is postponed while the base fee is above this value.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(30)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		EnableEventIndexer: config.Parameter{
+			ID:                   "enableEventIndexer",
+			Name:                 "Enable Event Indexer",
+			Description:          "Let the node daemon index the contract events of your operator, validators and vaults into a local database, starting from the block your operator was onboarded in. The first run scans the chain since then and puts extra load on your Execution client.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		UseFinalizedMetricsSnapshot: config.Parameter{
+			ID:                   "useFinalizedMetricsSnapshot",
+			Name:                 "Use Finalized Metrics Snapshot",
+			Description:          "Take the operator metrics at the latest finalized slot instead of the head slot. Finalized metrics lag the chain by a few minutes but are never affected by reorgs.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		StorePresignedExits: config.Parameter{
+			ID:                   "storePresignedExits",
+			Name:                 "Store Presigned Exits",
+			Description:          "Keep a plaintext copy of every presigned exit message sent to Stader in a file only readable by the node, so they can be checked with `stader-cli validator verify-exits`. Anyone with this file can exit your validators.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NodeSignerType: config.Parameter{
+			ID:                   "nodeSignerType",
+			Name:                 "Node Account Signer",
+			Description:          "Choose what signs transactions and messages for your node account. An external signer keeps the operator key, which controls your SD collateral and reward address, outside the node containers.\nThe node wallet is still needed for your validator keys.",
+			Type:                 config.ParameterType_Choice,
+			Default:              map[config.Network]interface{}{config.Network_All: config.NodeSignerType_Local},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+			Options: []config.ParameterOption{{
+				Name:        "Node Wallet",
+				Description: "Sign with the node account key derived from the node wallet's mnemonic.",
+				Value:       config.NodeSignerType_Local,
+			}, {
+				Name:        "Clef",
+				Description: "Sign with a Clef signer, using its `account_signTransaction` and `account_signData` API.",
+				Value:       config.NodeSignerType_Clef,
+			}, {
+				Name:        "JSON-RPC",
+				Description: "Sign with any signer that serves `eth_signTransaction` and `eth_sign`, like Web3Signer in eth1 mode.",
+				Value:       config.NodeSignerType_JsonRpc,
+			}, {
+				Name:        "Offline",
+				Description: "Keep the node account key on an offline machine. Write commands save unsigned transactions with `--unsigned-out`, which you sign with `stader-cli wallet sign-tx` on the offline machine and send with `stader-cli node broadcast`.",
+				Value:       config.NodeSignerType_Offline,
+			}},
+		},
+
+		NodeSignerUrl: config.Parameter{
+			ID:                   "nodeSignerUrl",
+			Name:                 "Node Account Signer URL",
+			Description:          "The HTTP URL or IPC socket path of the external node account signer. It must be reachable from inside the node containers. It isn't used when the key is kept offline.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NodeSignerAddress: config.Parameter{
+			ID:                   "nodeSignerAddress",
+			Name:                 "Node Account Address",
+			Description:          "The address of the node account held by the external signer. Every signature is checked against it.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
 		beaconChainUrl: map[config.Network]string{
 			config.Network_Mainnet: "https://beaconcha.in",
 			config.Network_Prater:  "https://prater.beaconcha.in",
@@ -264,6 +611,13 @@ func NewStadernodeConfig(cfg *StaderConfig) *StaderNodeConfig {
 			config.Network_Zhejiang: "0x90Da3CA75532A17ca38440a32595F036ecE46E85",
 		},
 
+		multicall3Address: map[config.Network]string{
+			config.Network_Prater:   "0xcA11bde05977b3631167028862bE2a173976CA11",
+			config.Network_Devnet:   "",
+			config.Network_Mainnet:  "0xcA11bde05977b3631167028862bE2a173976CA11",
+			config.Network_Zhejiang: "",
+		},
+
 		baseStaderBackendUrl: map[config.Network]string{
 			config.Network_Prater:   "https://ethx-offchain-preprod.staderlabs.com",
 			config.Network_Devnet:   "https://stage-ethx-offchain.staderlabs.click",
@@ -289,7 +643,27 @@ func (cfg *StaderNodeConfig) GetParameters() []*config.Parameter {
 		&cfg.ManualMaxFee,
 		&cfg.PriorityFee,
 		&cfg.TxFeeCap,
+		&cfg.UseExternalGasOracles,
 		&cfg.ArchiveECUrl,
+		&cfg.EnableHealthServer,
+		&cfg.HealthServerPort,
+		&cfg.HealthCheckWindow,
+		&cfg.EnableAutoClaimSpRewards,
+		&cfg.AutoClaimSpRewardsMinimum,
+		&cfg.AutoClaimSpRewardsMaxBaseFee,
+		&cfg.AutoSweepMode,
+		&cfg.AutoSweepElVaultThreshold,
+		&cfg.AutoSweepRewardsCollectorThreshold,
+		&cfg.AutoSweepMaxBaseFee,
+		&cfg.EnableAutoSendClRewards,
+		&cfg.AutoSendClRewardsMinimum,
+		&cfg.AutoSendClRewardsMaxBaseFee,
+		&cfg.EnableEventIndexer,
+		&cfg.UseFinalizedMetricsSnapshot,
+		&cfg.StorePresignedExits,
+		&cfg.NodeSignerType,
+		&cfg.NodeSignerUrl,
+		&cfg.NodeSignerAddress,
 	}
 }
 
@@ -342,10 +716,10 @@ func (cfg *StaderNodeConfig) GetPresignEncryptionKey() string {
 
 func (cfg *StaderNodeConfig) GetWalletPath() string {
 	if cfg.parent.IsNativeMode {
-		return filepath.Join(cfg.DataPath.Value.(string), "/masterami/wallet")
+		return filepath.Join(cfg.DataPath.Value.(string), WalletFolder, "wallet")
 	}
 
-	return filepath.Join(DaemonDataPath, "/masterami/wallet")
+	return filepath.Join(DaemonDataPath, WalletFolder, "wallet")
 }
 
 func (cfg *StaderNodeConfig) GetPasswordPath() string {
@@ -368,6 +742,11 @@ func (cfg *StaderNodeConfig) GetWalletPathInCLI() string {
 	return filepath.Join(cfg.DataPath.Value.(string), "wallet")
 }
 
+// Get the folder of the wallet the daemon uses, which also holds its imported validator keys
+func (cfg *StaderNodeConfig) GetWalletFolderInCLI() string {
+	return filepath.Join(cfg.DataPath.Value.(string), WalletFolder)
+}
+
 func (cfg *StaderNodeConfig) GetPasswordPathInCLI() string {
 	return filepath.Join(cfg.DataPath.Value.(string), "password")
 }
@@ -425,6 +804,15 @@ func (cfg *StaderNodeConfig) GetStaderConfigAddress() common.Address {
 	return common.HexToAddress(cfg.staderConfigAddress[cfg.Network.Value.(config.Network)])
 }
 
+// Get the Multicall3 address of the network, the zero address if it has none
+func (cfg *StaderNodeConfig) GetMulticall3Address() common.Address {
+	address := cfg.multicall3Address[cfg.Network.Value.(config.Network)]
+	if address == "" {
+		return common.Address{}
+	}
+	return common.HexToAddress(address)
+}
+
 func getDefaultDataDir(config *StaderConfig) string {
 	return filepath.Join(config.StaderDirectory, "data")
 }
@@ -453,6 +841,14 @@ func (cfg *StaderNodeConfig) GetSpRewardCyclePath(cycle int64, daemon bool) stri
 	return filepath.Join(cfg.DataPath.Value.(string), SpRewardsMerkleProofsFolder, fmt.Sprintf(MerkleProofsFormat, string(cfg.Network.Value.(config.Network)), cycle))
 }
 
+func (cfg *StaderNodeConfig) GetQuarantinedSpRewardCyclePath(cycle int64, daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, SpRewardsMerkleProofsFolder, QuarantineFolder, fmt.Sprintf(MerkleProofsFormat, string(cfg.Network.Value.(config.Network)), cycle))
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), SpRewardsMerkleProofsFolder, QuarantineFolder, fmt.Sprintf(MerkleProofsFormat, string(cfg.Network.Value.(config.Network)), cycle))
+}
+
 func (cfg *StaderNodeConfig) GetFeeRecipientFilePath() string {
 	if !cfg.parent.IsNativeMode {
 		return filepath.Join(DaemonDataPath, "validators", FeeRecipientFilename)
@@ -461,6 +857,46 @@ func (cfg *StaderNodeConfig) GetFeeRecipientFilePath() string {
 	return filepath.Join(cfg.DataPath.Value.(string), "validators", NativeFeeRecipientFilename)
 }
 
+func (cfg *StaderNodeConfig) GetPresignLedgerPath(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, PresignLedgerFilename)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), PresignLedgerFilename)
+}
+
+func (cfg *StaderNodeConfig) GetPresignedExitsPath(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, PresignedExitsFilename)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), PresignedExitsFilename)
+}
+
+func (cfg *StaderNodeConfig) GetSlashingProtectionPath(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, SlashingProtectionFolder)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), SlashingProtectionFolder)
+}
+
+func (cfg *StaderNodeConfig) GetTransactionStorePath(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, TransactionsFilename)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), TransactionsFilename)
+}
+
+func (cfg *StaderNodeConfig) GetEventIndexPath(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, EventIndexFolder)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), EventIndexFolder)
+}
+
 func (cfg *StaderNodeConfig) GetClaimData(cycles []*big.Int) ([]*big.Int, []*big.Int, [][][32]byte, error) {
 	// data to pass to socializing pool contract
 	amountSd := []*big.Int{}
@@ -507,10 +943,13 @@ func (cfg *StaderNodeConfig) GetClaimData(cycles []*big.Int) ([]*big.Int, []*big
 		// convert merkle proofs to [32]byte
 		cycleMerkleProofs := [][32]byte{}
 		for _, proof := range merkleData.Proof {
-			merkleProofBytes, err := hex.DecodeString(proof[2:])
+			merkleProofBytes, err := hex.DecodeString(strings.TrimPrefix(proof, "0x"))
 			if err != nil {
 				return nil, nil, nil, err
 			}
+			if len(merkleProofBytes) != 32 {
+				return nil, nil, nil, fmt.Errorf("invalid merkle proof %s for cycle %d", proof, cycle.Int64())
+			}
 			var proofBytes [32]byte
 			copy(proofBytes[:], merkleProofBytes[:32])
 			cycleMerkleProofs = append(cycleMerkleProofs, proofBytes)
@@ -33,6 +33,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/fatih/color"
 	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/txs"
 	"github.com/stader-labs/stader-node/shared/types/api"
 	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
 	"github.com/stader-labs/stader-node/shared/utils/log"
@@ -48,6 +49,10 @@ type ExecutionClientManager struct {
 	primaryReady    bool
 	fallbackReady   bool
 	ignoreSyncCheck bool
+
+	// Record of the transactions sent through the manager, and the command sending them
+	txStore   *txs.Store
+	txCommand string
 }
 
 // This is a signature for a wrapped ethclient.Client function
@@ -136,6 +141,17 @@ func (p *ExecutionClientManager) CallContract(ctx context.Context, call ethereum
 	return result.([]byte), err
 }
 
+// PendingCallContract executes an Ethereum contract call against the pending state.
+func (p *ExecutionClientManager) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+		return client.PendingCallContract(ctx, call)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]byte), err
+}
+
 /// ============================
 /// ContractTransactor Functions
 /// ============================
@@ -209,6 +225,18 @@ func (p *ExecutionClientManager) SuggestGasTipCap(ctx context.Context) (*big.Int
 	return result.(*big.Int), err
 }
 
+// FeeHistory retrieves the base fees, gas used ratios and priority fee percentiles of a range of blocks
+// ending with lastBlock, or the latest block if lastBlock is nil.
+func (p *ExecutionClientManager) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+		return client.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ethereum.FeeHistory), err
+}
+
 // EstimateGas tries to estimate the gas needed to execute a specific
 // transaction based on the current pending state of the backend blockchain.
 // There is no guarantee that this is the true gas limit requirement as other
@@ -229,7 +257,60 @@ func (p *ExecutionClientManager) SendTransaction(ctx context.Context, tx *types.
 	_, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
 		return nil, client.SendTransaction(ctx, tx)
 	})
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Record the transaction; it has been sent already, so a failure to record it isn't returned
+	if p.txStore != nil {
+		if err := p.txStore.RecordSent(tx, p.txCommand); err != nil {
+			p.logger.Printlnf("WARNING: Could not record transaction %s: %s", tx.Hash().Hex(), err.Error())
+		}
+	}
+	return nil
+}
+
+/// ============================
+/// TransactionTracker Functions
+/// ============================
+
+// Record the transactions sent through the manager in a store, along with the command sending them
+func (p *ExecutionClientManager) SetTransactionStore(store *txs.Store, command string) {
+	p.txStore = store
+	p.txCommand = command
+}
+
+// Get the transaction that replaced a transaction sent through the manager and whether it cancelled it; returns false if it wasn't replaced
+func (p *ExecutionClientManager) GetTransactionReplacement(hash common.Hash) (common.Hash, bool, bool) {
+	if p.txStore == nil {
+		return common.Hash{}, false, false
+	}
+	replacement, cancelled, replaced, err := p.txStore.GetReplacement(hash)
+	if err != nil {
+		p.logger.Printlnf("WARNING: Could not check if transaction %s was replaced: %s", hash.Hex(), err.Error())
+		return common.Hash{}, false, false
+	}
+	return replacement, cancelled, replaced
+}
+
+// Record the receipt of a transaction sent through the manager
+func (p *ExecutionClientManager) RecordTransactionReceipt(receipt *types.Receipt) {
+	if p.txStore == nil {
+		return
+	}
+	if err := p.txStore.RecordReceipt(receipt); err != nil {
+		p.logger.Printlnf("WARNING: Could not record transaction %s receipt: %s", receipt.TxHash.Hex(), err.Error())
+	}
+}
+
+// Record that the nonce of a transaction sent through the manager was used by another transaction
+func (p *ExecutionClientManager) RecordTransactionDropped(hash common.Hash) {
+	if p.txStore == nil {
+		return
+	}
+	if err := p.txStore.RecordDropped(hash); err != nil {
+		p.logger.Printlnf("WARNING: Could not record transaction %s as dropped: %s", hash.Hex(), err.Error())
+	}
 }
 
 /// ==========================
@@ -0,0 +1,694 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/stader-labs/stader-node/stader-lib/contracts"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+// Only blocks this far behind the head are indexed, so chain reorgs never reach the index
+const ConfirmationBlocks = 64
+
+// The permissionless pool the node EL vault belongs to
+const permissionlessPoolId = 1
+
+// The contracts events are indexed from
+const (
+	Contract_PermissionlessNodeRegistry = "PermissionlessNodeRegistry"
+	Contract_PermissionlessPool         = "PermissionlessPool"
+	Contract_SdCollateral               = "SdCollateral"
+	Contract_OperatorRewardsCollector   = "OperatorRewardsCollector"
+	Contract_SocializingPool            = "SocializingPool"
+	Contract_PenaltyTracker             = "PenaltyTracker"
+	Contract_NodeElRewardVault          = "NodeElRewardVault"
+	Contract_ValidatorWithdrawVault     = "ValidatorWithdrawVault"
+)
+
+// The protocol contracts the indexer reads
+type Contracts struct {
+	Pnr *stader.PermissionlessNodeRegistryContractManager
+	Pp  *stader.PermissionlessPoolContractManager
+	Sdc *stader.SdCollateralContractManager
+	Orc *stader.OperatorRewardsCollectorContractManager
+	Sp  *stader.SocializingPoolContractManager
+	Pt  *stader.PenaltyTrackerContractManager
+}
+
+// How far a run of the indexer got
+type Progress struct {
+	Onboarded   bool
+	StartBlock  uint64
+	LastBlock   uint64
+	TargetBlock uint64
+	NewEvents   int
+}
+
+// Indexes the events related to a node operator: the ones emitted for its address, its reward addresses,
+// its validators and their vaults. It backfills from the block the operator was onboarded in and then follows the chain.
+type Indexer struct {
+	store       *Store
+	ec          stader.ExecutionClient
+	contracts   Contracts
+	nodeAddress common.Address
+	logInterval uint64
+
+	// Validator withdraw vaults are queried together, so their logs are decoded by topic
+	withdrawVault       *contracts.ValidatorWithdrawVaultFilterer
+	withdrawVaultTopics []common.Hash
+}
+
+// The operator state events are matched against
+type operatorContext struct {
+	id             *big.Int
+	addresses      []common.Address
+	pubkeys        map[string]bool
+	elRewardVault  *contracts.NodeElRewardVaultFilterer
+	withdrawVaults []common.Address
+}
+
+// Create a new indexer for the given node; logInterval is the largest block range queried at once
+func NewIndexer(store *Store, ec stader.ExecutionClient, contractManagers Contracts, nodeAddress common.Address, logInterval int) (*Indexer, error) {
+	if logInterval <= 0 {
+		return nil, fmt.Errorf("invalid event log interval %d", logInterval)
+	}
+
+	withdrawVault, err := contracts.NewValidatorWithdrawVaultFilterer(common.Address{}, ec)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the withdraw vault filterer: %w", err)
+	}
+	withdrawVaultAbi, err := contracts.ValidatorWithdrawVaultMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the withdraw vault ABI: %w", err)
+	}
+	withdrawVaultTopics := []common.Hash{}
+	for _, name := range []string{"ETHReceived", "SettledFunds", "DistributedRewards"} {
+		event, exists := withdrawVaultAbi.Events[name]
+		if !exists {
+			return nil, fmt.Errorf("the withdraw vault ABI has no %s event", name)
+		}
+		withdrawVaultTopics = append(withdrawVaultTopics, event.ID)
+	}
+
+	return &Indexer{
+		store:               store,
+		ec:                  ec,
+		contracts:           contractManagers,
+		nodeAddress:         nodeAddress,
+		logInterval:         uint64(logInterval),
+		withdrawVault:       withdrawVault,
+		withdrawVaultTopics: withdrawVaultTopics,
+	}, nil
+}
+
+// Index the events up to the confirmed head.
+// When the context is done the run stops after the last complete range without an error, and the next one carries on from there.
+func (i *Indexer) Run(ctx context.Context) (Progress, error) {
+	progress := Progress{}
+
+	// Get the block to index up to
+	head, err := i.ec.BlockNumber(ctx)
+	if err != nil {
+		return progress, fmt.Errorf("error getting the latest block number: %w", err)
+	}
+	if head <= ConfirmationBlocks {
+		return progress, nil
+	}
+	progress.TargetBlock = head - ConfirmationBlocks
+
+	// Get the progress so far
+	cursor, exists, err := i.store.GetCursor(i.nodeAddress)
+	if err != nil {
+		return progress, err
+	}
+	if !exists {
+		// No operator was onboarded before the socializing pool was deployed, so the search for the onboarding block starts there
+		initialBlock, err := i.contracts.Sp.SocializingPool.InitialBlock(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return progress, fmt.Errorf("error getting the socializing pool initial block: %w", err)
+		}
+		if initialBlock.Uint64() > 0 {
+			cursor.LastBlock = initialBlock.Uint64() - 1
+		}
+	}
+
+	// Find the block the operator was onboarded in
+	if !cursor.Onboarded {
+		cursor, err = i.findOnboardingBlock(ctx, cursor, progress.TargetBlock)
+		progress.LastBlock = cursor.LastBlock
+		if err != nil || !cursor.Onboarded {
+			return progress, err
+		}
+	}
+	progress.Onboarded = true
+	progress.StartBlock = cursor.StartBlock
+
+	// Get the operator's addresses, validators and vaults
+	op, err := i.loadOperator(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			progress.LastBlock = cursor.LastBlock
+			return progress, nil
+		}
+		return progress, err
+	}
+
+	// Index the blocks since the last run, one range at a time
+	for cursor.LastBlock < progress.TargetBlock && ctx.Err() == nil {
+		from, to := i.nextRange(cursor.LastBlock, progress.TargetBlock)
+		events, err := i.indexRange(ctx, op, from, to)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			return progress, fmt.Errorf("error indexing blocks %d to %d: %w", from, to, err)
+		}
+		cursor.LastBlock = to
+		if err := i.store.SaveEvents(i.nodeAddress, events, cursor); err != nil {
+			return progress, err
+		}
+		progress.NewEvents += len(events)
+	}
+	progress.LastBlock = cursor.LastBlock
+	return progress, nil
+}
+
+// Search for the OnboardedOperator event of the node, saving the search progress after every range
+func (i *Indexer) findOnboardingBlock(ctx context.Context, cursor Cursor, target uint64) (Cursor, error) {
+	nodes := []common.Address{i.nodeAddress}
+	for cursor.LastBlock < target && ctx.Err() == nil {
+		from, to := i.nextRange(cursor.LastBlock, target)
+		it, err := i.contracts.Pnr.PermissionlessNodeRegistry.FilterOnboardedOperator(i.filterOpts(ctx, from, to), nodes)
+		var onboardingBlock uint64
+		found := false
+		err = drain("OnboardedOperator", it, err, func() {
+			if !found {
+				onboardingBlock = it.Event.Raw.BlockNumber
+				found = true
+			}
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			return cursor, err
+		}
+
+		// Indexing starts at the onboarding block, so its own events are picked up too
+		if found {
+			cursor.Onboarded = true
+			cursor.StartBlock = onboardingBlock
+			cursor.LastBlock = onboardingBlock - 1
+		} else {
+			cursor.LastBlock = to
+		}
+		if err := i.store.SaveEvents(i.nodeAddress, nil, cursor); err != nil {
+			return cursor, err
+		}
+		if found {
+			break
+		}
+	}
+	return cursor, nil
+}
+
+// Get the current state of the operator, along with the reward addresses it used before
+func (i *Indexer) loadOperator(ctx context.Context) (*operatorContext, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	pnr := i.contracts.Pnr
+
+	operatorId, err := node.GetOperatorId(pnr, i.nodeAddress, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the operator id: %w", err)
+	}
+	operatorInfo, err := node.GetOperatorInfo(pnr, operatorId, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the operator info: %w", err)
+	}
+	validators, err := node.GetAllValidatorsInfoByOperator(pnr, i.nodeAddress, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the operator validators: %w", err)
+	}
+	elRewardAddress, err := node.GetNodeElRewardAddress(pnr, permissionlessPoolId, operatorId, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the EL reward vault address: %w", err)
+	}
+
+	op := &operatorContext{
+		id:      operatorId,
+		pubkeys: map[string]bool{},
+	}
+	op.addAddress(i.nodeAddress)
+	op.addAddress(operatorInfo.OperatorRewardAddress)
+	for _, validator := range validators {
+		op.addPubkey(validator.Pubkey)
+		op.withdrawVaults = append(op.withdrawVaults, validator.WithdrawVaultAddress)
+	}
+	if elRewardAddress != (common.Address{}) {
+		op.elRewardVault, err = contracts.NewNodeElRewardVaultFilterer(elRewardAddress, i.ec)
+		if err != nil {
+			return nil, fmt.Errorf("error creating the EL reward vault filterer: %w", err)
+		}
+	}
+
+	// Rewards sent to a previous reward address still belong to the operator
+	events, err := i.store.GetEvents(i.nodeAddress)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		if rewardAddress, exists := event.Args["rewardAddress"]; exists {
+			op.addAddress(common.HexToAddress(rewardAddress))
+		}
+	}
+
+	return op, nil
+}
+
+// Get the events of the operator in a block range.
+// The node registry is read first so validators and reward addresses added in the range are matched by the later queries.
+func (i *Indexer) indexRange(ctx context.Context, op *operatorContext, from uint64, to uint64) ([]Event, error) {
+	opts := i.filterOpts(ctx, from, to)
+	nodes := []common.Address{i.nodeAddress}
+	events := []Event{}
+	pnr := i.contracts.Pnr.PermissionlessNodeRegistry
+
+	// Operator events of the node registry
+	onboarded, err := pnr.FilterOnboardedOperator(opts, nodes)
+	err = drain("OnboardedOperator", onboarded, err, func() {
+		e := onboarded.Event
+		events = append(events, newEvent(Contract_PermissionlessNodeRegistry, "OnboardedOperator", e.Raw, map[string]string{
+			"nodeOperator":            addressArg(e.NodeOperator),
+			"rewardAddress":           addressArg(e.NodeRewardAddress),
+			"operatorId":              bigArg(e.OperatorId),
+			"optInForSocializingPool": strconv.FormatBool(e.OptInForSocializingPool),
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	details, err := pnr.FilterUpdatedOperatorDetails(opts, nodes)
+	err = drain("UpdatedOperatorDetails", details, err, func() {
+		e := details.Event
+		op.addAddress(e.RewardAddress)
+		events = append(events, newEvent(Contract_PermissionlessNodeRegistry, "UpdatedOperatorDetails", e.Raw, map[string]string{
+			"nodeOperator":  addressArg(e.NodeOperator),
+			"operatorName":  e.OperatorName,
+			"rewardAddress": addressArg(e.RewardAddress),
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	addedKeys, err := pnr.FilterAddedValidatorKey(opts, nodes)
+	err = drain("AddedValidatorKey", addedKeys, err, func() {
+		e := addedKeys.Event
+		op.addPubkey(e.Pubkey)
+		events = append(events, newEvent(Contract_PermissionlessNodeRegistry, "AddedValidatorKey", e.Raw, map[string]string{
+			"nodeOperator": addressArg(e.NodeOperator),
+			"pubkey":       bytesArg(e.Pubkey),
+			"validatorId":  bigArg(e.ValidatorId),
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	spState, err := pnr.FilterUpdatedSocializingPoolState(opts)
+	err = drain("UpdatedSocializingPoolState", spState, err, func() {
+		e := spState.Event
+		if e.OperatorId == nil || e.OperatorId.Cmp(op.id) != 0 {
+			return
+		}
+		events = append(events, newEvent(Contract_PermissionlessNodeRegistry, "UpdatedSocializingPoolState", e.Raw, map[string]string{
+			"operatorId":              bigArg(e.OperatorId),
+			"optedForSocializingPool": strconv.FormatBool(e.OptedForSocializingPool),
+			"block":                   bigArg(e.Block),
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Validator events aren't indexed by operator, so they're matched by pubkey
+	if len(op.pubkeys) > 0 {
+		validatorEvents, err := i.getValidatorEvents(opts, op)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, validatorEvents...)
+	}
+
+	// SD collateral
+	sdDeposited, err := i.contracts.Sdc.SdCollateral.FilterSDDeposited(opts, nodes)
+	err = drain("SDDeposited", sdDeposited, err, func() {
+		e := sdDeposited.Event
+		events = append(events, newEvent(Contract_SdCollateral, "SDDeposited", e.Raw, map[string]string{
+			"operator": addressArg(e.Operator),
+			"sdAmount": bigArg(e.SdAmount),
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	sdWithdrawn, err := i.contracts.Sdc.SdCollateral.FilterSDWithdrawn(opts, nodes)
+	err = drain("SDWithdrawn", sdWithdrawn, err, func() {
+		e := sdWithdrawn.Event
+		events = append(events, newEvent(Contract_SdCollateral, "SDWithdrawn", e.Raw, map[string]string{
+			"operator": addressArg(e.Operator),
+			"sdAmount": bigArg(e.SdAmount),
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	sdSlashed, err := i.contracts.Sdc.SdCollateral.FilterSDSlashed(opts, nodes, nil)
+	err = drain("SDSlashed", sdSlashed, err, func() {
+		e := sdSlashed.Event
+		events = append(events, newEvent(Contract_SdCollateral, "SDSlashed", e.Raw, map[string]string{
+			"operator":  addressArg(e.Operator),
+			"auction":   addressArg(e.Auction),
+			"sdSlashed": bigArg(e.SdSlashed),
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Operator rewards
+	claimed, err := i.contracts.Orc.OperatorRewardsCollector.FilterClaimed(opts, op.addresses)
+	err = drain("Claimed", claimed, err, func() {
+		e := claimed.Event
+		events = append(events, newEvent(Contract_OperatorRewardsCollector, "Claimed", e.Raw, map[string]string{
+			"receiver": addressArg(e.Receiver),
+			"amount":   bigArg(e.Amount),
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	depositedFor, err := i.contracts.Orc.OperatorRewardsCollector.FilterDepositedFor(opts, nil, op.addresses)
+	err = drain("DepositedFor", depositedFor, err, func() {
+		e := depositedFor.Event
+		events = append(events, newEvent(Contract_OperatorRewardsCollector, "DepositedFor", e.Raw, map[string]string{
+			"sender":   addressArg(e.Sender),
+			"receiver": addressArg(e.Receiver),
+			"amount":   bigArg(e.Amount),
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	spClaimed, err := i.contracts.Sp.SocializingPool.FilterOperatorRewardsClaimed(opts, op.addresses)
+	err = drain("OperatorRewardsClaimed", spClaimed, err, func() {
+		e := spClaimed.Event
+		events = append(events, newEvent(Contract_SocializingPool, "OperatorRewardsClaimed", e.Raw, map[string]string{
+			"recipient":  addressArg(e.Recipient),
+			"ethRewards": bigArg(e.EthRewards),
+			"sdRewards":  bigArg(e.SdRewards),
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Vaults
+	if op.elRewardVault != nil {
+		elEvents, err := getElRewardVaultEvents(opts, op.elRewardVault)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, elEvents...)
+	}
+	if len(op.withdrawVaults) > 0 {
+		withdrawVaultEvents, err := i.getWithdrawVaultEvents(ctx, op, from, to)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, withdrawVaultEvents...)
+	}
+
+	return events, nil
+}
+
+// Get the events of the operator's validators from the node registry, the permissionless pool and the penalty tracker
+func (i *Indexer) getValidatorEvents(opts *bind.FilterOpts, op *operatorContext) ([]Event, error) {
+	events := []Event{}
+	pnr := i.contracts.Pnr.PermissionlessNodeRegistry
+	addValidatorEvent := func(contract string, name string, raw types.Log, pubkey []byte, validatorId *big.Int) {
+		if !op.hasPubkey(pubkey) {
+			return
+		}
+		args := map[string]string{"pubkey": bytesArg(pubkey)}
+		if validatorId != nil {
+			args["validatorId"] = bigArg(validatorId)
+		}
+		events = append(events, newEvent(contract, name, raw, args))
+	}
+
+	readyToDeposit, err := pnr.FilterValidatorMarkedReadyToDeposit(opts)
+	err = drain("ValidatorMarkedReadyToDeposit", readyToDeposit, err, func() {
+		e := readyToDeposit.Event
+		addValidatorEvent(Contract_PermissionlessNodeRegistry, "ValidatorMarkedReadyToDeposit", e.Raw, e.Pubkey, e.ValidatorId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	frontRunned, err := pnr.FilterValidatorMarkedAsFrontRunned(opts)
+	err = drain("ValidatorMarkedAsFrontRunned", frontRunned, err, func() {
+		e := frontRunned.Event
+		addValidatorEvent(Contract_PermissionlessNodeRegistry, "ValidatorMarkedAsFrontRunned", e.Raw, e.Pubkey, e.ValidatorId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	invalidSignature, err := pnr.FilterValidatorStatusMarkedAsInvalidSignature(opts)
+	err = drain("ValidatorStatusMarkedAsInvalidSignature", invalidSignature, err, func() {
+		e := invalidSignature.Event
+		addValidatorEvent(Contract_PermissionlessNodeRegistry, "ValidatorStatusMarkedAsInvalidSignature", e.Raw, e.Pubkey, e.ValidatorId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	withdrawn, err := pnr.FilterValidatorWithdrawn(opts)
+	err = drain("ValidatorWithdrawn", withdrawn, err, func() {
+		e := withdrawn.Event
+		addValidatorEvent(Contract_PermissionlessNodeRegistry, "ValidatorWithdrawn", e.Raw, e.Pubkey, e.ValidatorId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	preDeposited, err := i.contracts.Pp.PermissionlessPool.FilterValidatorPreDepositedOnBeaconChain(opts)
+	err = drain("ValidatorPreDepositedOnBeaconChain", preDeposited, err, func() {
+		e := preDeposited.Event
+		addValidatorEvent(Contract_PermissionlessPool, "ValidatorPreDepositedOnBeaconChain", e.Raw, e.PubKey, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	deposited, err := i.contracts.Pp.PermissionlessPool.FilterValidatorDepositedOnBeaconChain(opts, nil)
+	err = drain("ValidatorDepositedOnBeaconChain", deposited, err, func() {
+		e := deposited.Event
+		addValidatorEvent(Contract_PermissionlessPool, "ValidatorDepositedOnBeaconChain", e.Raw, e.PubKey, e.ValidatorId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	forceExit, err := i.contracts.Pt.Penalty.FilterForceExitValidator(opts)
+	err = drain("ForceExitValidator", forceExit, err, func() {
+		e := forceExit.Event
+		addValidatorEvent(Contract_PenaltyTracker, "ForceExitValidator", e.Raw, e.Pubkey, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	settled, err := i.contracts.Pt.Penalty.FilterValidatorMarkedAsSettled(opts)
+	err = drain("ValidatorMarkedAsSettled", settled, err, func() {
+		e := settled.Event
+		addValidatorEvent(Contract_PenaltyTracker, "ValidatorMarkedAsSettled", e.Raw, e.Pubkey, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// Get the EL rewards received and split by the operator's EL reward vault
+func getElRewardVaultEvents(opts *bind.FilterOpts, vault *contracts.NodeElRewardVaultFilterer) ([]Event, error) {
+	events := []Event{}
+	received, err := vault.FilterETHReceived(opts, nil)
+	err = drain("ETHReceived", received, err, func() {
+		e := received.Event
+		events = append(events, newEvent(Contract_NodeElRewardVault, "ETHReceived", e.Raw, map[string]string{
+			"sender": addressArg(e.Sender),
+			"amount": bigArg(e.Amount),
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	withdrawals, err := vault.FilterWithdrawal(opts)
+	err = drain("Withdrawal", withdrawals, err, func() {
+		e := withdrawals.Event
+		events = append(events, newEvent(Contract_NodeElRewardVault, "Withdrawal", e.Raw, map[string]string{
+			"protocolAmount": bigArg(e.ProtocolAmount),
+			"operatorAmount": bigArg(e.OperatorAmount),
+			"userAmount":     bigArg(e.UserAmount),
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Get the events of every withdraw vault of the operator with a single log query instead of one per vault
+func (i *Indexer) getWithdrawVaultEvents(ctx context.Context, op *operatorContext, from uint64, to uint64) ([]Event, error) {
+	logs, err := i.ec.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: op.withdrawVaults,
+		Topics:    [][]common.Hash{i.withdrawVaultTopics},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error filtering withdraw vault events: %w", err)
+	}
+
+	events := []Event{}
+	for _, log := range logs {
+		if log.Removed || len(log.Topics) == 0 {
+			continue
+		}
+		switch log.Topics[0] {
+		case i.withdrawVaultTopics[0]:
+			e, err := i.withdrawVault.ParseETHReceived(log)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding ETHReceived event: %w", err)
+			}
+			events = append(events, newEvent(Contract_ValidatorWithdrawVault, "ETHReceived", log, map[string]string{
+				"sender": addressArg(e.Sender),
+				"amount": bigArg(e.Amount),
+			}))
+		case i.withdrawVaultTopics[1]:
+			e, err := i.withdrawVault.ParseSettledFunds(log)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding SettledFunds event: %w", err)
+			}
+			events = append(events, newEvent(Contract_ValidatorWithdrawVault, "SettledFunds", log, sharesArgs(e.UserShare, e.OperatorShare, e.ProtocolShare)))
+		case i.withdrawVaultTopics[2]:
+			e, err := i.withdrawVault.ParseDistributedRewards(log)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding DistributedRewards event: %w", err)
+			}
+			events = append(events, newEvent(Contract_ValidatorWithdrawVault, "DistributedRewards", log, sharesArgs(e.UserShare, e.OperatorShare, e.ProtocolShare)))
+		}
+	}
+	return events, nil
+}
+
+// Get the next block range to index after the given block
+func (i *Indexer) nextRange(lastBlock uint64, target uint64) (uint64, uint64) {
+	from := lastBlock + 1
+	to := from + i.logInterval - 1
+	if to > target {
+		to = target
+	}
+	return from, to
+}
+
+func (i *Indexer) filterOpts(ctx context.Context, from uint64, to uint64) *bind.FilterOpts {
+	return &bind.FilterOpts{
+		Start:   from,
+		End:     &to,
+		Context: ctx,
+	}
+}
+
+func (op *operatorContext) addAddress(address common.Address) {
+	if address == (common.Address{}) {
+		return
+	}
+	for _, existing := range op.addresses {
+		if existing == address {
+			return
+		}
+	}
+	op.addresses = append(op.addresses, address)
+}
+
+func (op *operatorContext) addPubkey(pubkey []byte) {
+	op.pubkeys[hexutil.Encode(pubkey)] = true
+}
+
+func (op *operatorContext) hasPubkey(pubkey []byte) bool {
+	return op.pubkeys[hexutil.Encode(pubkey)]
+}
+
+// The iterators returned by the generated Filter* bindings
+type eventIterator interface {
+	Next() bool
+	Error() error
+	Close() error
+}
+
+// Call handle for every event of a filter iterator, then close it
+func drain(name string, it eventIterator, err error, handle func()) error {
+	if err != nil {
+		return fmt.Errorf("error filtering %s events: %w", name, err)
+	}
+	defer it.Close()
+	for it.Next() {
+		handle()
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("error reading %s events: %w", name, err)
+	}
+	return nil
+}
+
+func newEvent(contract string, name string, raw types.Log, args map[string]string) Event {
+	return Event{
+		Contract:    contract,
+		Name:        name,
+		Address:     raw.Address,
+		BlockNumber: raw.BlockNumber,
+		TxHash:      raw.TxHash,
+		LogIndex:    raw.Index,
+		Args:        args,
+	}
+}
+
+func sharesArgs(userShare *big.Int, operatorShare *big.Int, protocolShare *big.Int) map[string]string {
+	return map[string]string{
+		"userShare":     bigArg(userShare),
+		"operatorShare": bigArg(operatorShare),
+		"protocolShare": bigArg(protocolShare),
+	}
+}
+
+func addressArg(address common.Address) string {
+	return address.Hex()
+}
+
+func bigArg(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+	return value.String()
+}
+
+func bytesArg(value []byte) string {
+	return hexutil.Encode(value)
+}
@@ -0,0 +1,208 @@
+package events
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+)
+
+// Config
+const (
+	storeVersion = 1
+
+	// LevelDB cache size in MB and open file handles; the index is small, so the minimums are enough
+	cacheSize   = 16
+	fileHandles = 16
+
+	// Only one process can hold the index at a time, so opening it is retried while the other one finishes
+	openAttempts   = 10
+	openRetryDelay = 500 * time.Millisecond
+)
+
+// Key layout
+var (
+	versionKey   = []byte("version")
+	cursorPrefix = []byte("cursor-")
+	eventPrefix  = []byte("event-")
+)
+
+// The index hasn't been created yet
+var ErrNoIndex = errors.New("the event index has not been created yet; it is built by the node daemon when the event indexer is enabled")
+
+// A decoded contract event related to the operator
+type Event struct {
+	Contract    string            `json:"contract"`
+	Name        string            `json:"name"`
+	Address     common.Address    `json:"address"`
+	BlockNumber uint64            `json:"blockNumber"`
+	TxHash      common.Hash       `json:"txHash"`
+	LogIndex    uint              `json:"logIndex"`
+	Args        map[string]string `json:"args"`
+}
+
+// How far the index of an operator has progressed
+type Cursor struct {
+	// Whether the operator's onboarding block has been found yet
+	Onboarded bool `json:"onboarded"`
+
+	// The block the operator was onboarded in, where indexing starts
+	StartBlock uint64 `json:"startBlock"`
+
+	// The last block indexed, or searched for the onboarding event until the operator is onboarded
+	LastBlock uint64 `json:"lastBlock"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Embedded database of the contract events related to node operators.
+// LevelDB locks the database while it is open, so the daemon only keeps it open while indexing.
+type Store struct {
+	db *leveldb.Database
+}
+
+// Open the store at the given path, creating it unless it is opened read-only
+func OpenStore(path string, readOnly bool) (*Store, error) {
+	if readOnly {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return nil, ErrNoIndex
+		}
+	}
+
+	var db *leveldb.Database
+	var err error
+	for attempt := 1; attempt <= openAttempts; attempt++ {
+		db, err = leveldb.New(path, cacheSize, fileHandles, "", readOnly)
+		if err == nil {
+			break
+		}
+		time.Sleep(openRetryDelay)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not open the event index at %s (it may be in use by the node daemon): %w", path, err)
+	}
+	s := &Store{db: db}
+
+	// Check the version
+	if err := s.checkVersion(readOnly); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close the store, releasing its lock
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Get the indexing progress of an operator, and whether it has been indexed before
+func (s *Store) GetCursor(operator common.Address) (Cursor, bool, error) {
+	var cursor Cursor
+	key := cursorKey(operator)
+	exists, err := s.db.Has(key)
+	if err != nil {
+		return cursor, false, fmt.Errorf("could not read the cursor of operator %s: %w", operator.Hex(), err)
+	}
+	if !exists {
+		return cursor, false, nil
+	}
+	bytes, err := s.db.Get(key)
+	if err != nil {
+		return cursor, false, fmt.Errorf("could not read the cursor of operator %s: %w", operator.Hex(), err)
+	}
+	if err := json.Unmarshal(bytes, &cursor); err != nil {
+		return cursor, false, fmt.Errorf("could not deserialize the cursor of operator %s: %w", operator.Hex(), err)
+	}
+	return cursor, true, nil
+}
+
+// Save the events of an operator along with its updated cursor, atomically
+func (s *Store) SaveEvents(operator common.Address, events []Event, cursor Cursor) error {
+	batch := s.db.NewBatch()
+	for _, event := range events {
+		bytes, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("could not serialize %s event: %w", event.Name, err)
+		}
+		if err := batch.Put(eventKey(operator, event.BlockNumber, event.LogIndex), bytes); err != nil {
+			return err
+		}
+	}
+
+	cursor.UpdatedAt = time.Now()
+	bytes, err := json.Marshal(cursor)
+	if err != nil {
+		return fmt.Errorf("could not serialize the cursor of operator %s: %w", operator.Hex(), err)
+	}
+	if err := batch.Put(cursorKey(operator), bytes); err != nil {
+		return err
+	}
+
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("could not save the events of operator %s: %w", operator.Hex(), err)
+	}
+	return nil
+}
+
+// Get the indexed events of an operator, oldest first
+func (s *Store) GetEvents(operator common.Address) ([]Event, error) {
+	prefix := append(append([]byte{}, eventPrefix...), operator.Bytes()...)
+	it := s.db.NewIterator(prefix, nil)
+	defer it.Release()
+
+	events := []Event{}
+	for it.Next() {
+		var event Event
+		if err := json.Unmarshal(it.Value(), &event); err != nil {
+			return nil, fmt.Errorf("could not deserialize event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("could not read the events of operator %s: %w", operator.Hex(), err)
+	}
+	return events, nil
+}
+
+// Check the store was written with the current layout, stamping new stores
+func (s *Store) checkVersion(readOnly bool) error {
+	exists, err := s.db.Has(versionKey)
+	if err != nil {
+		return fmt.Errorf("could not read the event index version: %w", err)
+	}
+	if !exists {
+		if readOnly {
+			return nil
+		}
+		return s.db.Put(versionKey, []byte{storeVersion})
+	}
+	version, err := s.db.Get(versionKey)
+	if err != nil {
+		return fmt.Errorf("could not read the event index version: %w", err)
+	}
+	if len(version) != 1 || version[0] != storeVersion {
+		return fmt.Errorf("unsupported event index version %v", version)
+	}
+	return nil
+}
+
+// Cursors are keyed by operator address
+func cursorKey(operator common.Address) []byte {
+	return append(append([]byte{}, cursorPrefix...), operator.Bytes()...)
+}
+
+// Events are keyed by operator address, block number and log index so iterating them is chronological,
+// and indexing the same range again overwrites the events instead of duplicating them
+func eventKey(operator common.Address, blockNumber uint64, logIndex uint) []byte {
+	position := make([]byte, 12)
+	binary.BigEndian.PutUint64(position[:8], blockNumber)
+	binary.BigEndian.PutUint32(position[8:], uint32(logIndex))
+	key := append(append([]byte{}, eventPrefix...), operator.Bytes()...)
+	return append(key, position...)
+}
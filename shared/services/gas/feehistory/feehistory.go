@@ -0,0 +1,78 @@
+package feehistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+// Number of recent blocks the suggestions are based on
+const blockCount uint64 = 20
+
+// The slow, standard and fast max base fees, as fractions of the next block's base fee.
+// The base fee rises by at most 12.5% per block, so these cover 1, about 3 and about 6 full blocks in a row.
+var baseFeeMultipliers = [][2]int64{
+	{9, 8},
+	{3, 2},
+	{2, 1},
+}
+
+// Execution clients that provide the fee history of recent blocks
+type Client interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+}
+
+// Max base fee suggestions computed from the fee history of recent blocks.
+// The priority fee is not part of them; it comes from the user's settings.
+type GasFeeSuggestion struct {
+	NextBaseFeeWei *big.Int `json:"nextBaseFeeWei"`
+	OldestBlock    uint64   `json:"oldestBlock"`
+	BlockCount     uint64   `json:"blockCount"`
+	SlowWei        *big.Int `json:"slowWei"`
+	StandardWei    *big.Int `json:"standardWei"`
+	FastWei        *big.Int `json:"fastWei"`
+}
+
+// Get gas prices from the fee history of the latest blocks
+func GetGasPrices(client Client) (GasFeeSuggestion, error) {
+
+	// Get the fee history
+	history, err := client.FeeHistory(context.Background(), blockCount, nil, nil)
+	if err != nil {
+		return GasFeeSuggestion{}, fmt.Errorf("could not get fee history: %w", err)
+	}
+	if len(history.BaseFee) == 0 {
+		return GasFeeSuggestion{}, errors.New("the fee history has no base fees; the network may not support EIP-1559")
+	}
+
+	// The last base fee is the next block's
+	nextBaseFee := history.BaseFee[len(history.BaseFee)-1]
+	if nextBaseFee == nil {
+		return GasFeeSuggestion{}, errors.New("the fee history has no base fee for the next block")
+	}
+
+	// Build the suggestions
+	suggestions := make([]*big.Int, len(baseFeeMultipliers))
+	for i, multiplier := range baseFeeMultipliers {
+		suggestions[i] = new(big.Int).Mul(nextBaseFee, big.NewInt(multiplier[0]))
+		suggestions[i].Div(suggestions[i], big.NewInt(multiplier[1]))
+	}
+
+	// Return
+	var oldestBlock uint64
+	if history.OldestBlock != nil {
+		oldestBlock = history.OldestBlock.Uint64()
+	}
+	return GasFeeSuggestion{
+		NextBaseFeeWei: nextBaseFee,
+		OldestBlock:    oldestBlock,
+		BlockCount:     uint64(len(history.GasUsedRatio)),
+		SlowWei:        suggestions[0],
+		StandardWei:    suggestions[1],
+		FastWei:        suggestions[2],
+	}, nil
+
+}
@@ -23,11 +23,13 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 
 	"github.com/stader-labs/stader-node/shared/utils/log"
 
 	"github.com/stader-labs/stader-node/shared/services/gas/etherchain"
 	"github.com/stader-labs/stader-node/shared/services/gas/etherscan"
+	"github.com/stader-labs/stader-node/shared/services/gas/feehistory"
 	"github.com/stader-labs/stader-node/shared/services/stader"
 	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
 	"github.com/stader-labs/stader-node/shared/utils/math"
@@ -37,6 +39,11 @@ import (
 
 func AssignMaxFeeAndLimit(gasInfo staderCore.GasInfo, staderClient *stader.Client, headless bool) error {
 
+	// Stop if the simulation shows the transaction would fail
+	if gasInfo.Simulation.Failed() {
+		return fmt.Errorf("The transaction would fail if it were sent: %s", gasInfo.Simulation.Message)
+	}
+
 	cfg, isNew, err := staderClient.LoadConfig()
 	if err != nil {
 		return fmt.Errorf("Error getting Stader configuration: %w", err)
@@ -83,30 +90,47 @@ func AssignMaxFeeAndLimit(gasInfo staderCore.GasInfo, staderClient *stader.Clien
 		fmt.Printf("Total cost: %.4f to %.4f ETH%s\n", lowLimit, highLimit, log.ColorReset)
 
 	} else {
-		if headless {
-			maxFeeWei, err := GetHeadlessMaxFeeWei()
-			if err != nil {
-				return err
+		useExternal := cfg.StaderNode.UseExternalGasOracles.Value.(bool)
+		// Get the gas prices from the fee history of the Execution client.
+		// Headless commands use the default the prompt would offer: the fast suggestion plus the priority fee.
+		gasPrices, err := staderClient.GetGasPrices()
+		if err == nil {
+			if headless {
+				maxFeeGwei = math.RoundUp(eth.WeiToGwei(gasPrices.GasPrices.FastWei)+maxPriorityFeeGwei, 2)
+			} else {
+				// Print the suggestions and ask for an amount
+				maxFeeGwei = handleFeeHistoryGasPrices(gasPrices.GasPrices, gasInfo, maxPriorityFeeGwei, gasLimit, useExternal)
 			}
-			maxFeeGwei = eth.WeiToGwei(maxFeeWei)
-		} else {
-			// Try to get the latest gas prices from Etherchain
+
+		} else if useExternal {
+			// Fallback to Etherchain
+			fmt.Printf("%sWarning: couldn't get gas estimates from the Execution client - %s\nFalling back to Etherchain%s\n", log.ColorYellow, err.Error(), log.ColorReset)
 			etherchainData, err := etherchain.GetGasPrices()
 			if err == nil {
-				// Print the Etherchain data and ask for an amount
-				maxFeeGwei = handleEtherchainGasPrices(etherchainData, gasInfo, maxPriorityFeeGwei, gasLimit)
+				if headless {
+					maxFeeGwei = math.RoundUp(eth.WeiToGwei(etherchainData.FastWei)+maxPriorityFeeGwei, 0)
+				} else {
+					// Print the Etherchain data and ask for an amount
+					maxFeeGwei = handleEtherchainGasPrices(etherchainData, gasInfo, maxPriorityFeeGwei, gasLimit)
+				}
 
 			} else {
 				// Fallback to Etherscan
 				fmt.Printf("%sWarning: couldn't get gas estimates from Etherchain - %s\nFalling back to Etherscan%s\n", log.ColorYellow, err.Error(), log.ColorReset)
 				etherscanData, err := etherscan.GetGasPrices()
-				if err == nil {
+				if err != nil {
+					return fmt.Errorf("Error getting gas price suggestions: %w", err)
+				}
+				if headless {
+					maxFeeGwei = math.RoundUp(etherscanData.FastGwei+maxPriorityFeeGwei, 0)
+				} else {
 					// Print the Etherscan data and ask for an amount
 					maxFeeGwei = handleEtherscanGasPrices(etherscanData, gasInfo, maxPriorityFeeGwei, gasLimit)
-				} else {
-					return fmt.Errorf("Error getting gas price suggestions: %w", err)
 				}
 			}
+
+		} else {
+			return fmt.Errorf("Error getting gas price suggestions: %w", err)
 		}
 		fmt.Printf("%sUsing a max fee of %.2f gwei and a priority fee of %.2f gwei.\n%s", log.ColorBlue, maxFeeGwei, maxPriorityFeeGwei, log.ColorReset)
 	}
@@ -124,20 +148,98 @@ func AssignMaxFeeAndLimit(gasInfo staderCore.GasInfo, staderClient *stader.Clien
 
 }
 
-// Get the suggested max fee for service operations
-func GetHeadlessMaxFeeWei() (*big.Int, error) {
-	etherchainData, err := etherchain.GetGasPrices()
-	if err == nil {
-		return etherchainData.RapidWei, nil
+func handleFeeHistoryGasPrices(gasSuggestion feehistory.GasFeeSuggestion, gasInfo staderCore.GasInfo, priorityFee float64, gasLimit uint64, useExternal bool) float64 {
+
+	// Get the external suggestions to compare with; they aren't needed, so failures are only noted
+	var externalName string
+	var externalGwei [3]float64
+	if useExternal {
+		etherchainData, err := etherchain.GetGasPrices()
+		if err == nil {
+			externalName = "Etherchain"
+			externalGwei = [3]float64{
+				math.RoundUp(eth.WeiToGwei(etherchainData.FastWei)+priorityFee, 2),
+				math.RoundUp(eth.WeiToGwei(etherchainData.StandardWei)+priorityFee, 2),
+				math.RoundUp(eth.WeiToGwei(etherchainData.SlowWei)+priorityFee, 2),
+			}
+		} else {
+			etherscanData, err := etherscan.GetGasPrices()
+			if err == nil {
+				externalName = "Etherscan"
+				externalGwei = [3]float64{
+					math.RoundUp(etherscanData.FastGwei+priorityFee, 2),
+					math.RoundUp(etherscanData.StandardGwei+priorityFee, 2),
+					math.RoundUp(etherscanData.SlowGwei+priorityFee, 2),
+				}
+			} else {
+				fmt.Printf("%sNOTE: couldn't get gas estimates from Etherchain or Etherscan to compare with - %s%s\n", log.ColorYellow, err.Error(), log.ColorReset)
+			}
+		}
+	}
+
+	speeds := []string{"Fast", "Standard", "Slow"}
+	suggestions := []*big.Int{gasSuggestion.FastWei, gasSuggestion.StandardWei, gasSuggestion.SlowWei}
+	maxFeesGwei := make([]float64, len(suggestions))
+	for i, suggestion := range suggestions {
+		maxFeesGwei[i] = math.RoundUp(eth.WeiToGwei(suggestion)+priorityFee, 2)
 	}
 
-	fmt.Printf("%sWarning: couldn't get gas estimates from Etherchain - %s\nFalling back to Etherscan%s\n", log.ColorYellow, err.Error(), log.ColorReset)
-	etherscanData, err := etherscan.GetGasPrices()
-	if err == nil {
-		return eth.GweiToWei(etherscanData.FastGwei), nil
+	header := "|   Speed   |   Max Fee    |    Total Gas Cost    |"
+	if externalName != "" {
+		header += fmt.Sprintf(" %-12s |", externalName)
 	}
+	title := " Suggested Gas Prices "
+	padding := len(header) - len(title) - 2
+	fmt.Printf("%s+%s%s%s+\n", log.ColorBlue, strings.Repeat("=", padding/2), title, strings.Repeat("=", padding-padding/2))
+	fmt.Println(header)
+	for i := range suggestions {
+		lowLimit, highLimit := getGasCostRange(maxFeesGwei[i], gasInfo, gasLimit)
+		row := fmt.Sprintf("| %-9s | %-12s | %.4f to %.4f ETH |",
+			speeds[i], fmt.Sprintf("%.2f gwei", maxFeesGwei[i]), lowLimit, highLimit)
+		if externalName != "" {
+			row += fmt.Sprintf(" %-12s |", fmt.Sprintf("%.2f gwei", externalGwei[i]))
+		}
+		fmt.Println(row)
+	}
+	fmt.Printf("+%s+\n\n%s", strings.Repeat("=", len(header)-2), log.ColorReset)
+
+	fmt.Printf("These prices are based on the last %d blocks, with a next base fee of %.2f gwei, and include a maximum priority fee of %.2f gwei.\n",
+		gasSuggestion.BlockCount, eth.WeiToGwei(gasSuggestion.NextBaseFeeWei), priorityFee)
 
-	return nil, fmt.Errorf("Error getting gas price suggestions: %w", err)
+	fastGwei := maxFeesGwei[0]
+	for {
+		desiredPrice := cliutils.Prompt(
+			fmt.Sprintf("Please enter your max fee (including the priority fee) or leave blank for the default of %.2f gwei:", fastGwei),
+			"^(?:[1-9]\\d*|0)?(?:\\.\\d+)?$",
+			"Not a valid gas price, try again:")
+
+		if desiredPrice == "" {
+			return fastGwei
+		}
+
+		desiredPriceFloat, err := strconv.ParseFloat(desiredPrice, 64)
+		if err != nil {
+			fmt.Printf("Not a valid gas price (%s), try again.\n", err.Error())
+			continue
+		}
+		if desiredPriceFloat <= 0 {
+			fmt.Println("Max fee must be greater than zero.")
+			continue
+		}
+
+		return desiredPriceFloat
+	}
+
+}
+
+// Get the lowest and highest total cost of a transaction in ETH for a max fee in gwei
+func getGasCostRange(maxFeeGwei float64, gasInfo staderCore.GasInfo, gasLimit uint64) (float64, float64) {
+	maxFeeEth := maxFeeGwei / eth.WeiPerGwei
+	if gasLimit != 0 {
+		cost := maxFeeEth * float64(gasLimit)
+		return cost, cost
+	}
+	return maxFeeEth * float64(gasInfo.EstGasLimit), maxFeeEth * float64(gasInfo.SafeGasLimit)
 }
 
 func handleEtherchainGasPrices(gasSuggestion etherchain.GasFeeSuggestion, gasInfo staderCore.GasInfo, priorityFee float64, gasLimit uint64) float64 {
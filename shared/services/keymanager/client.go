@@ -0,0 +1,280 @@
+package keymanager
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	hexutil "github.com/stader-labs/stader-node/shared/utils/hex"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Config
+const (
+	RequestUrlFormat   = "%s%s"
+	RequestContentType = "application/json"
+
+	RequestKeystoresPath    = "/eth/v1/keystores"
+	RequestRemoteKeysPath   = "/eth/v1/remotekeys"
+	RequestFeeRecipientPath = "/eth/v1/validator/%s/feerecipient"
+	RequestGraffitiPath     = "/eth/v1/validator/%s/graffiti"
+
+	ImportStatus_Imported  = "imported"
+	ImportStatus_Duplicate = "duplicate"
+
+	requestTimeout = 30 * time.Second
+)
+
+// A keystore loaded by the validator client
+type Keystore struct {
+	Pubkey   types.ValidatorPubkey
+	ReadOnly bool
+}
+
+// A remote signer key loaded by the validator client
+type RemoteKey struct {
+	Pubkey types.ValidatorPubkey
+	Url    string
+}
+
+// Client for the standard Keymanager API of a validator client
+type Client struct {
+	providerAddress string
+	token           string
+	httpClient      *http.Client
+}
+
+// Create a new client for the Keymanager API at the given address, authenticating with a bearer token
+func NewClient(providerAddress string, token string) *Client {
+	return &Client{
+		providerAddress: strings.TrimSuffix(providerAddress, "/"),
+		token:           token,
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+// Get the keystores loaded by the validator client
+func (c *Client) ListKeystores() ([]Keystore, error) {
+	responseBody, status, err := c.sendRequest(http.MethodGet, RequestKeystoresPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get keystores: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Could not get keystores: %s", getErrorMessage(status, responseBody))
+	}
+	var response ListKeystoresResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode keystores: %w", err)
+	}
+
+	keystores := make([]Keystore, len(response.Data))
+	for i, keystore := range response.Data {
+		pubkey, err := types.HexToValidatorPubkey(hexutil.RemovePrefix(keystore.ValidatingPubkey))
+		if err != nil {
+			return nil, fmt.Errorf("Could not decode keystore pubkey %s: %w", keystore.ValidatingPubkey, err)
+		}
+		keystores[i] = Keystore{
+			Pubkey:   pubkey,
+			ReadOnly: keystore.ReadOnly,
+		}
+	}
+	return keystores, nil
+}
+
+// Get the remote signer keys loaded by the validator client
+func (c *Client) ListRemoteKeys() ([]RemoteKey, error) {
+	responseBody, status, err := c.sendRequest(http.MethodGet, RequestRemoteKeysPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get remote keys: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Could not get remote keys: %s", getErrorMessage(status, responseBody))
+	}
+	var response ListRemoteKeysResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode remote keys: %w", err)
+	}
+
+	remoteKeys := make([]RemoteKey, len(response.Data))
+	for i, remoteKey := range response.Data {
+		pubkey, err := types.HexToValidatorPubkey(hexutil.RemovePrefix(remoteKey.Pubkey))
+		if err != nil {
+			return nil, fmt.Errorf("Could not decode remote key pubkey %s: %w", remoteKey.Pubkey, err)
+		}
+		remoteKeys[i] = RemoteKey{
+			Pubkey: pubkey,
+			Url:    remoteKey.Url,
+		}
+	}
+	return remoteKeys, nil
+}
+
+// Load a validator key held by a remote signer into the validator client.
+// Importing a key that is already loaded is not an error.
+func (c *Client) ImportRemoteKey(pubkey types.ValidatorPubkey, signerUrl string) error {
+	responseBody, status, err := c.sendRequest(http.MethodPost, RequestRemoteKeysPath, ImportRemoteKeysRequest{
+		RemoteKeys: []RemoteKeyData{{
+			Pubkey: hexutil.AddPrefix(pubkey.Hex()),
+			Url:    signerUrl,
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("Could not import remote key %s: %w", pubkey.Hex(), err)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Could not import remote key %s: %s", pubkey.Hex(), getErrorMessage(status, responseBody))
+	}
+	var response ImportRemoteKeysResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return fmt.Errorf("Could not decode remote key import response: %w", err)
+	}
+	if len(response.Data) != 1 {
+		return fmt.Errorf("Validator client returned %d import statuses for 1 remote key", len(response.Data))
+	}
+	switch response.Data[0].Status {
+	case ImportStatus_Imported, ImportStatus_Duplicate:
+		return nil
+	default:
+		return fmt.Errorf("Validator client did not import remote key %s: %s (%s)", pubkey.Hex(), response.Data[0].Status, response.Data[0].Message)
+	}
+}
+
+// Get the fee recipient the validator client uses for a validator
+func (c *Client) GetFeeRecipient(pubkey types.ValidatorPubkey) (common.Address, error) {
+	responseBody, status, err := c.sendRequest(http.MethodGet, fmt.Sprintf(RequestFeeRecipientPath, hexutil.AddPrefix(pubkey.Hex())), nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("Could not get fee recipient of validator %s: %w", pubkey.Hex(), err)
+	}
+	if status != http.StatusOK {
+		return common.Address{}, fmt.Errorf("Could not get fee recipient of validator %s: %s", pubkey.Hex(), getErrorMessage(status, responseBody))
+	}
+	var response FeeRecipientResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return common.Address{}, fmt.Errorf("Could not decode fee recipient of validator %s: %w", pubkey.Hex(), err)
+	}
+	if !common.IsHexAddress(response.Data.EthAddress) {
+		return common.Address{}, fmt.Errorf("Invalid fee recipient '%s' for validator %s", response.Data.EthAddress, pubkey.Hex())
+	}
+	return common.HexToAddress(response.Data.EthAddress), nil
+}
+
+// Set the fee recipient the validator client uses for a validator
+func (c *Client) SetFeeRecipient(pubkey types.ValidatorPubkey, feeRecipient common.Address) error {
+	responseBody, status, err := c.sendRequest(http.MethodPost, fmt.Sprintf(RequestFeeRecipientPath, hexutil.AddPrefix(pubkey.Hex())), SetFeeRecipientRequest{
+		EthAddress: feeRecipient.Hex(),
+	})
+	if err != nil {
+		return fmt.Errorf("Could not set fee recipient of validator %s: %w", pubkey.Hex(), err)
+	}
+	if status != http.StatusAccepted {
+		return fmt.Errorf("Could not set fee recipient of validator %s: %s", pubkey.Hex(), getErrorMessage(status, responseBody))
+	}
+	return nil
+}
+
+// Get the graffiti the validator client uses for a validator
+func (c *Client) GetGraffiti(pubkey types.ValidatorPubkey) (string, error) {
+	responseBody, status, err := c.sendRequest(http.MethodGet, fmt.Sprintf(RequestGraffitiPath, hexutil.AddPrefix(pubkey.Hex())), nil)
+	if err != nil {
+		return "", fmt.Errorf("Could not get graffiti of validator %s: %w", pubkey.Hex(), err)
+	}
+	if status != http.StatusOK {
+		return "", fmt.Errorf("Could not get graffiti of validator %s: %s", pubkey.Hex(), getErrorMessage(status, responseBody))
+	}
+	var response GraffitiResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return "", fmt.Errorf("Could not decode graffiti of validator %s: %w", pubkey.Hex(), err)
+	}
+	return response.Data.Graffiti, nil
+}
+
+// Set the graffiti the validator client uses for a validator
+func (c *Client) SetGraffiti(pubkey types.ValidatorPubkey, graffiti string) error {
+	responseBody, status, err := c.sendRequest(http.MethodPost, fmt.Sprintf(RequestGraffitiPath, hexutil.AddPrefix(pubkey.Hex())), SetGraffitiRequest{
+		Graffiti: graffiti,
+	})
+	if err != nil {
+		return fmt.Errorf("Could not set graffiti of validator %s: %w", pubkey.Hex(), err)
+	}
+	if status != http.StatusAccepted {
+		return fmt.Errorf("Could not set graffiti of validator %s: %s", pubkey.Hex(), getErrorMessage(status, responseBody))
+	}
+	return nil
+}
+
+// Make an authenticated request to the Keymanager API
+func (c *Client) sendRequest(method string, requestPath string, requestBody interface{}) ([]byte, int, error) {
+
+	// Get request body
+	var requestBodyReader io.Reader
+	if requestBody != nil {
+		requestBodyBytes, err := json.Marshal(requestBody)
+		if err != nil {
+			return []byte{}, 0, err
+		}
+		requestBodyReader = bytes.NewReader(requestBodyBytes)
+	}
+
+	// Build request
+	request, err := http.NewRequest(method, fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), requestBodyReader)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	request.Header.Set("Authorization", "Bearer "+c.token)
+	request.Header.Set("Accept", RequestContentType)
+	if requestBody != nil {
+		request.Header.Set("Content-Type", RequestContentType)
+	}
+
+	// Send request
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	// Get response
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+
+	// Return
+	return body, response.StatusCode, nil
+
+}
+
+// Get a readable error from a failed Keymanager API response
+func getErrorMessage(status int, responseBody []byte) string {
+	var response ErrorResponse
+	if err := json.Unmarshal(responseBody, &response); err == nil && response.Message != "" {
+		return fmt.Sprintf("HTTP status %d; %s", status, response.Message)
+	}
+	return fmt.Sprintf("HTTP status %d; response body: '%s'", status, string(responseBody))
+}
+
+// Read the API token the validator client generated.
+// Some clients, like Prysm, write the API address before the token, so the last non-empty line is used.
+func ReadToken(path string) (string, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("could not read Keymanager API token file: %w", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(bytes)), "\n")
+	token := strings.TrimSpace(lines[len(lines)-1])
+	if token == "" {
+		return "", fmt.Errorf("Keymanager API token file %s is empty", path)
+	}
+	return token, nil
+}
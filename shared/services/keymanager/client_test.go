@@ -0,0 +1,258 @@
+package keymanager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+const testToken = "api-token-0123456789"
+
+var testPubkey, _ = types.HexToValidatorPubkey(strings.Repeat("ab", types.ValidatorPubkeyLength))
+
+// A stand-in validator client serving the Keymanager API for one validator
+type testKeymanager struct {
+	feeRecipient string
+	graffiti     string
+	remoteKeys   map[string]string
+}
+
+func newTestServer(t *testing.T, km *testKeymanager) *httptest.Server {
+	pubkey := "0x" + testPubkey.Hex()
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(RequestKeystoresPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"validating_pubkey": pubkey, "derivation_path": "m/12381/3600/0/0/0", "readonly": true},
+			},
+		})
+	})
+
+	mux.HandleFunc(RequestRemoteKeysPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			data := []RemoteKeyData{}
+			for key, url := range km.remoteKeys {
+				data = append(data, RemoteKeyData{Pubkey: key, Url: url})
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
+			return
+		}
+		var request ImportRemoteKeysRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			writeJSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+		statuses := []map[string]string{}
+		for _, key := range request.RemoteKeys {
+			status := ImportStatus_Imported
+			if _, exists := km.remoteKeys[key.Pubkey]; exists {
+				status = ImportStatus_Duplicate
+			}
+			km.remoteKeys[key.Pubkey] = key.Url
+			statuses = append(statuses, map[string]string{"status": status})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": statuses})
+	})
+
+	mux.HandleFunc(fmt.Sprintf(RequestFeeRecipientPath, pubkey), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"data": map[string]string{"pubkey": pubkey, "ethaddress": km.feeRecipient},
+			})
+			return
+		}
+		var request SetFeeRecipientRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil || !common.IsHexAddress(request.EthAddress) {
+			writeJSON(w, http.StatusBadRequest, ErrorResponse{Message: "invalid ethaddress"})
+			return
+		}
+		km.feeRecipient = request.EthAddress
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	mux.HandleFunc(fmt.Sprintf(RequestGraffitiPath, pubkey), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"data": map[string]string{"pubkey": pubkey, "graffiti": km.graffiti},
+			})
+			return
+		}
+		var request SetGraffitiRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			writeJSON(w, http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+			return
+		}
+		km.graffiti = request.Graffiti
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	// Every endpoint requires the bearer token
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			writeJSON(w, http.StatusUnauthorized, ErrorResponse{Message: "Unauthorized"})
+			return
+		}
+		if r.Method == http.MethodPost && r.Header.Get("Content-Type") != RequestContentType {
+			writeJSON(w, http.StatusUnsupportedMediaType, ErrorResponse{Message: "Unsupported content type"})
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", RequestContentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestBearerAuth(t *testing.T) {
+	server := newTestServer(t, &testKeymanager{})
+
+	_, err := NewClient(server.URL, "wrong-token").ListKeystores()
+	if err == nil {
+		t.Fatal("expected an error with a wrong token")
+	}
+	if !strings.Contains(err.Error(), "HTTP status 401; Unauthorized") {
+		t.Errorf("unexpected error with a wrong token: %s", err)
+	}
+
+	// A trailing slash in the address is ignored
+	if _, err := NewClient(server.URL+"/", testToken).ListKeystores(); err != nil {
+		t.Errorf("unexpected error with the right token: %s", err)
+	}
+}
+
+func TestListKeystores(t *testing.T) {
+	server := newTestServer(t, &testKeymanager{})
+
+	keystores, err := NewClient(server.URL, testToken).ListKeystores()
+	if err != nil {
+		t.Fatalf("error listing keystores: %s", err)
+	}
+	if len(keystores) != 1 || keystores[0].Pubkey != testPubkey || !keystores[0].ReadOnly {
+		t.Errorf("unexpected keystores %+v", keystores)
+	}
+}
+
+func TestImportRemoteKey(t *testing.T) {
+	km := &testKeymanager{remoteKeys: map[string]string{}}
+	server := newTestServer(t, km)
+	client := NewClient(server.URL, testToken)
+
+	// Importing the same key twice is not an error
+	for i := 0; i < 2; i++ {
+		if err := client.ImportRemoteKey(testPubkey, "http://web3signer:9000"); err != nil {
+			t.Fatalf("error importing remote key (attempt %d): %s", i+1, err)
+		}
+	}
+
+	remoteKeys, err := client.ListRemoteKeys()
+	if err != nil {
+		t.Fatalf("error listing remote keys: %s", err)
+	}
+	if len(remoteKeys) != 1 || remoteKeys[0].Pubkey != testPubkey || remoteKeys[0].Url != "http://web3signer:9000" {
+		t.Errorf("unexpected remote keys %+v", remoteKeys)
+	}
+}
+
+func TestFeeRecipient(t *testing.T) {
+	km := &testKeymanager{feeRecipient: "0x0000000000000000000000000000000000000001"}
+	server := newTestServer(t, km)
+	client := NewClient(server.URL, testToken)
+
+	feeRecipient, err := client.GetFeeRecipient(testPubkey)
+	if err != nil {
+		t.Fatalf("error getting fee recipient: %s", err)
+	}
+	if feeRecipient != common.HexToAddress(km.feeRecipient) {
+		t.Errorf("unexpected fee recipient %s", feeRecipient.Hex())
+	}
+
+	newFeeRecipient := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	if err := client.SetFeeRecipient(testPubkey, newFeeRecipient); err != nil {
+		t.Fatalf("error setting fee recipient: %s", err)
+	}
+	feeRecipient, err = client.GetFeeRecipient(testPubkey)
+	if err != nil {
+		t.Fatalf("error getting fee recipient: %s", err)
+	}
+	if feeRecipient != newFeeRecipient {
+		t.Errorf("fee recipient is %s after setting it to %s", feeRecipient.Hex(), newFeeRecipient.Hex())
+	}
+
+	// An invalid fee recipient from the validator client is rejected
+	km.feeRecipient = "not-an-address"
+	if _, err := client.GetFeeRecipient(testPubkey); err == nil {
+		t.Error("expected an error for an invalid fee recipient")
+	}
+
+	// Unknown validators fail with the server's status
+	otherPubkey, _ := types.HexToValidatorPubkey(strings.Repeat("cd", types.ValidatorPubkeyLength))
+	if err := client.SetFeeRecipient(otherPubkey, newFeeRecipient); err == nil || !strings.Contains(err.Error(), "HTTP status 404") {
+		t.Errorf("expected a 404 error for an unknown validator, got %v", err)
+	}
+}
+
+func TestGraffiti(t *testing.T) {
+	km := &testKeymanager{graffiti: "old graffiti"}
+	server := newTestServer(t, km)
+	client := NewClient(server.URL, testToken)
+
+	graffiti, err := client.GetGraffiti(testPubkey)
+	if err != nil {
+		t.Fatalf("error getting graffiti: %s", err)
+	}
+	if graffiti != "old graffiti" {
+		t.Errorf("unexpected graffiti '%s'", graffiti)
+	}
+
+	if err := client.SetGraffiti(testPubkey, "Stader"); err != nil {
+		t.Fatalf("error setting graffiti: %s", err)
+	}
+	if km.graffiti != "Stader" {
+		t.Errorf("graffiti is '%s' after setting it to 'Stader'", km.graffiti)
+	}
+}
+
+func TestReadToken(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "api-token.txt")
+
+	// Prysm writes the API address before the token
+	if err := ioutil.WriteFile(path, []byte("http://validator:5062\n"+testToken+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	token, err := ReadToken(path)
+	if err != nil {
+		t.Fatalf("error reading token: %s", err)
+	}
+	if token != testToken {
+		t.Errorf("unexpected token '%s'", token)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadToken(path); err == nil {
+		t.Error("expected an error for an empty token file")
+	}
+
+	if _, err := ReadToken(filepath.Join(dir, "missing.txt")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected a not-exist error for a missing token file, got %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+package keymanager
+
+// Request types
+type SetFeeRecipientRequest struct {
+	EthAddress string `json:"ethaddress"`
+}
+type SetGraffitiRequest struct {
+	Graffiti string `json:"graffiti"`
+}
+type ImportRemoteKeysRequest struct {
+	RemoteKeys []RemoteKeyData `json:"remote_keys"`
+}
+type RemoteKeyData struct {
+	Pubkey string `json:"pubkey"`
+	Url    string `json:"url"`
+}
+
+// Response types
+type ListKeystoresResponse struct {
+	Data []struct {
+		ValidatingPubkey string `json:"validating_pubkey"`
+		DerivationPath   string `json:"derivation_path"`
+		ReadOnly         bool   `json:"readonly"`
+	} `json:"data"`
+}
+type ListRemoteKeysResponse struct {
+	Data []struct {
+		RemoteKeyData
+		ReadOnly bool `json:"readonly"`
+	} `json:"data"`
+}
+type ImportRemoteKeysResponse struct {
+	Data []struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	} `json:"data"`
+}
+type FeeRecipientResponse struct {
+	Data struct {
+		Pubkey     string `json:"pubkey"`
+		EthAddress string `json:"ethaddress"`
+	} `json:"data"`
+}
+type GraffitiResponse struct {
+	Data struct {
+		Pubkey   string `json:"pubkey"`
+		Graffiti string `json:"graffiti"`
+	} `json:"data"`
+}
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
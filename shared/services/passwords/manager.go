@@ -44,6 +44,11 @@ func NewPasswordManager(passwordPath string) *PasswordManager {
 	}
 }
 
+// Get the path of the password file
+func (pm *PasswordManager) GetPasswordPath() string {
+	return pm.passwordPath
+}
+
 // Check if the password has been set
 func (pm *PasswordManager) IsPasswordSet() bool {
 	_, err := ioutil.ReadFile(pm.passwordPath)
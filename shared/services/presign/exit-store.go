@@ -0,0 +1,132 @@
+package presign
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Config
+const exitStoreVersion = 1
+
+// A plaintext presigned exit message, as handed over to the Stader backend
+type StoredExit struct {
+	Pubkey         types.ValidatorPubkey    `json:"pubkey"`
+	ValidatorIndex uint64                   `json:"validatorIndex"`
+	Epoch          uint64                   `json:"epoch"`
+	Signature      types.ValidatorSignature `json:"signature"`
+	SignedAt       time.Time                `json:"signedAt"`
+}
+
+// The on-disk format of the exit store
+type exitStoreFile struct {
+	Version int                    `json:"version"`
+	Exits   map[string]*StoredExit `json:"exits"`
+}
+
+// Local copy of the presigned exit messages. The messages can exit the validators, so the file is only readable by its owner.
+type ExitStore struct {
+	path  string
+	exits map[string]*StoredExit
+	lock  sync.Mutex
+}
+
+// Create a new exit store, loading the existing one from disk if present
+func NewExitStore(path string) (*ExitStore, error) {
+	s := &ExitStore{
+		path:  path,
+		exits: map[string]*StoredExit{},
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Record the presigned exit message of a validator, replacing any previous one
+func (s *ExitStore) Record(exit StoredExit) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.exits[exit.Pubkey.Hex()] = &exit
+}
+
+// Get all stored exit messages, sorted by pubkey
+func (s *ExitStore) GetExits() []StoredExit {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	exits := make([]StoredExit, 0, len(s.exits))
+	for _, exit := range s.exits {
+		exits = append(exits, *exit)
+	}
+	sort.Slice(exits, func(i, j int) bool {
+		return exits[i].Pubkey.Hex() < exits[j].Pubkey.Hex()
+	})
+	return exits
+}
+
+// Write the exit store to disk
+func (s *ExitStore) Save() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	bytes, err := json.MarshalIndent(exitStoreFile{
+		Version: exitStoreVersion,
+		Exits:   s.exits,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not serialize presigned exits: %w", err)
+	}
+
+	// Make sure the data dir exists
+	err = os.MkdirAll(filepath.Dir(s.path), 0755)
+	if err != nil {
+		return fmt.Errorf("could not create presigned exits directory: %w", err)
+	}
+
+	// Write to a temporary file first so an interrupted write never corrupts the store.
+	// A leftover temporary file could have looser permissions, so it is removed first.
+	tempPath := s.path + ".tmp"
+	if err := os.Remove(tempPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("could not remove stale presigned exits file: %w", err)
+	}
+	if err := ioutil.WriteFile(tempPath, bytes, FileMode); err != nil {
+		return fmt.Errorf("could not write presigned exits to disk: %w", err)
+	}
+	if err := os.Rename(tempPath, s.path); err != nil {
+		return fmt.Errorf("could not replace presigned exits file: %w", err)
+	}
+
+	return nil
+}
+
+// Read the exit store from disk
+func (s *ExitStore) load() error {
+	bytes, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("could not read presigned exits from disk: %w", err)
+	}
+
+	var file exitStoreFile
+	if err := json.Unmarshal(bytes, &file); err != nil {
+		return fmt.Errorf("could not parse presigned exits file %s: %w", s.path, err)
+	}
+	if file.Version != exitStoreVersion {
+		return fmt.Errorf("unsupported presigned exits file version %d", file.Version)
+	}
+	if file.Exits != nil {
+		s.exits = file.Exits
+	}
+
+	return nil
+}
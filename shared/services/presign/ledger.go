@@ -0,0 +1,270 @@
+/*
+This work is licensed and released under GNU GPL v3 or any other later versions.
+The full text of the license is below/ found at <http://www.gnu.org/licenses/>
+
+(c) 2023 Rocket Pool Pty Ltd. Modified under GNU GPL v3. [1.2.0]
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+package presign
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Config
+const (
+	FileMode      = 0600
+	ledgerVersion = 1
+)
+
+// The state of a validator's presigned exit message in the ledger
+type EntryState string
+
+const (
+	// The presigned message was sent to the backend but not acknowledged yet
+	EntryState_Submitted EntryState = "submitted"
+
+	// The backend has the presigned message on file
+	EntryState_Acknowledged EntryState = "acknowledged"
+
+	// The last attempt to build or send the presigned message failed
+	EntryState_Failed EntryState = "failed"
+
+	// The validator does not need a presigned message (terminal in the contracts, or already exiting)
+	EntryState_Skipped EntryState = "skipped"
+)
+
+// A single validator's record in the presign ledger
+type LedgerEntry struct {
+	Pubkey         types.ValidatorPubkey `json:"pubkey"`
+	State          EntryState            `json:"state"`
+	ValidatorIndex uint64                `json:"validatorIndex"`
+	ContractStatus uint8                 `json:"contractStatus"`
+	BeaconStatus   beacon.ValidatorState `json:"beaconStatus,omitempty"`
+	ExitEpoch      uint64                `json:"exitEpoch"`
+	SubmittedAt    time.Time             `json:"submittedAt,omitempty"`
+	AcknowledgedAt time.Time             `json:"acknowledgedAt,omitempty"`
+	LastError      string                `json:"lastError,omitempty"`
+	LastErrorAt    time.Time             `json:"lastErrorAt,omitempty"`
+	UpdatedAt      time.Time             `json:"updatedAt"`
+}
+
+// The on-disk format of the ledger
+type ledgerFile struct {
+	Version int                     `json:"version"`
+	Entries map[string]*LedgerEntry `json:"entries"`
+}
+
+// Persistent record of the presigned exit messages handed over to the Stader backend
+type Ledger struct {
+	path    string
+	entries map[string]*LedgerEntry
+	lock    sync.Mutex
+}
+
+// Create a new ledger, loading the existing one from disk if present
+func NewLedger(path string) (*Ledger, error) {
+	l := &Ledger{
+		path:    path,
+		entries: map[string]*LedgerEntry{},
+	}
+	if err := l.load(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Check whether a validator has to be processed in the next presign pass.
+// Only new validators, validators whose last attempt did not complete, and validators whose state in the contracts changed need it.
+func (l *Ledger) NeedsCheck(pubkey types.ValidatorPubkey, contractStatus uint8) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry, exists := l.entries[pubkey.Hex()]
+	if !exists {
+		return true
+	}
+	if entry.ContractStatus != contractStatus {
+		return true
+	}
+	return entry.State == EntryState_Submitted || entry.State == EntryState_Failed
+}
+
+// Get the entry of a validator
+func (l *Ledger) GetEntry(pubkey types.ValidatorPubkey) (LedgerEntry, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry, exists := l.entries[pubkey.Hex()]
+	if !exists {
+		return LedgerEntry{}, false
+	}
+	return *entry, true
+}
+
+// Get all entries, sorted by pubkey
+func (l *Ledger) GetEntries() []LedgerEntry {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entries := make([]LedgerEntry, 0, len(l.entries))
+	for _, entry := range l.entries {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Pubkey.Hex() < entries[j].Pubkey.Hex()
+	})
+	return entries
+}
+
+// Record the contract and beacon chain state of a validator
+func (l *Ledger) RecordStatus(pubkey types.ValidatorPubkey, contractStatus uint8, beaconStatus beacon.ValidatorState) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry := l.getOrCreateEntry(pubkey)
+	entry.ContractStatus = contractStatus
+	if beaconStatus != "" {
+		entry.BeaconStatus = beaconStatus
+	}
+	entry.UpdatedAt = time.Now()
+}
+
+// Record that a presigned message was sent to the backend
+func (l *Ledger) RecordSubmitted(pubkey types.ValidatorPubkey, validatorIndex uint64, exitEpoch uint64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	entry := l.getOrCreateEntry(pubkey)
+	entry.State = EntryState_Submitted
+	entry.ValidatorIndex = validatorIndex
+	entry.ExitEpoch = exitEpoch
+	entry.SubmittedAt = now
+	entry.UpdatedAt = now
+}
+
+// Record that the backend has the presigned message of a validator on file
+func (l *Ledger) RecordAcknowledged(pubkey types.ValidatorPubkey) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	entry := l.getOrCreateEntry(pubkey)
+	entry.State = EntryState_Acknowledged
+	entry.AcknowledgedAt = now
+	entry.LastError = ""
+	entry.UpdatedAt = now
+}
+
+// Record that the validator does not need a presigned message
+func (l *Ledger) RecordSkipped(pubkey types.ValidatorPubkey, reason string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry := l.getOrCreateEntry(pubkey)
+	entry.State = EntryState_Skipped
+	entry.LastError = reason
+	entry.UpdatedAt = time.Now()
+}
+
+// Record a failure while building or sending the presigned message of a validator
+func (l *Ledger) RecordError(pubkey types.ValidatorPubkey, err string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	entry := l.getOrCreateEntry(pubkey)
+	entry.State = EntryState_Failed
+	entry.LastError = err
+	entry.LastErrorAt = now
+	entry.UpdatedAt = now
+}
+
+// Write the ledger to disk
+func (l *Ledger) Save() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	bytes, err := json.MarshalIndent(ledgerFile{
+		Version: ledgerVersion,
+		Entries: l.entries,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not serialize presign ledger: %w", err)
+	}
+
+	// Make sure the data dir exists
+	err = os.MkdirAll(filepath.Dir(l.path), 0755)
+	if err != nil {
+		return fmt.Errorf("could not create presign ledger directory: %w", err)
+	}
+
+	// Write to a temporary file first so an interrupted write never corrupts the ledger
+	tempPath := l.path + ".tmp"
+	if err := ioutil.WriteFile(tempPath, bytes, FileMode); err != nil {
+		return fmt.Errorf("could not write presign ledger to disk: %w", err)
+	}
+	if err := os.Rename(tempPath, l.path); err != nil {
+		return fmt.Errorf("could not replace presign ledger: %w", err)
+	}
+
+	return nil
+}
+
+// Read the ledger from disk
+func (l *Ledger) load() error {
+	bytes, err := ioutil.ReadFile(l.path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("could not read presign ledger from disk: %w", err)
+	}
+
+	var file ledgerFile
+	if err := json.Unmarshal(bytes, &file); err != nil {
+		return fmt.Errorf("could not parse presign ledger %s: %w", l.path, err)
+	}
+	if file.Version != ledgerVersion {
+		return fmt.Errorf("unsupported presign ledger version %d", file.Version)
+	}
+	if file.Entries != nil {
+		l.entries = file.Entries
+	}
+
+	return nil
+}
+
+// Get the entry of a validator, creating it if it does not exist yet. The lock must be held by the caller.
+func (l *Ledger) getOrCreateEntry(pubkey types.ValidatorPubkey) *LedgerEntry {
+	entry, exists := l.entries[pubkey.Hex()]
+	if !exists {
+		entry = &LedgerEntry{
+			Pubkey: pubkey,
+		}
+		l.entries[pubkey.Hex()] = entry
+	}
+	return entry
+}
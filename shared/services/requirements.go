@@ -76,7 +76,7 @@ func RequireNodeWallet(c *cli.Context) error {
 }
 
 func RequireEthClientSynced(c *cli.Context) error {
-	ethClientSynced, err := waitEthClientSynced(c, false, EthClientSyncTimeout)
+	ethClientSynced, err := waitEthClientSynced(context.Background(), c, false, EthClientSyncTimeout)
 	if err != nil {
 		return err
 	}
@@ -87,7 +87,7 @@ func RequireEthClientSynced(c *cli.Context) error {
 }
 
 func RequireBeaconClientSynced(c *cli.Context) error {
-	beaconClientSynced, err := waitBeaconClientSynced(c, false, BeaconClientSyncTimeout)
+	beaconClientSynced, err := waitBeaconClientSynced(context.Background(), c, false, BeaconClientSyncTimeout)
 	if err != nil {
 		return err
 	}
@@ -188,12 +188,22 @@ func WaitNodeRegistered(c *cli.Context, operatorAddress common.Address, verbose
 }
 
 func WaitEthClientSynced(c *cli.Context, verbose bool) error {
-	_, err := waitEthClientSynced(c, verbose, 0)
-	return err
+	return WaitEthClientSyncedContext(context.Background(), c, verbose)
 }
 
 func WaitBeaconClientSynced(c *cli.Context, verbose bool) error {
-	_, err := waitBeaconClientSynced(c, verbose, 0)
+	return WaitBeaconClientSyncedContext(context.Background(), c, verbose)
+}
+
+// Wait for the eth client to sync, giving up when the context is done
+func WaitEthClientSyncedContext(ctx context.Context, c *cli.Context, verbose bool) error {
+	_, err := waitEthClientSynced(ctx, c, verbose, 0)
+	return err
+}
+
+// Wait for the beacon client to sync, giving up when the context is done
+func WaitBeaconClientSyncedContext(ctx context.Context, c *cli.Context, verbose bool) error {
+	_, err := waitBeaconClientSynced(ctx, c, verbose, 0)
 	return err
 }
 
@@ -350,7 +360,7 @@ func checkBeaconClientStatus(bcMgr *BeaconClientManager) (bool, error) {
 	return false, fmt.Errorf("Primary consensus client is unavailable (%s) and no fallback consensus client is configured.", mgrStatus.PrimaryClientStatus.Error)
 }
 
-func waitEthClientSynced(c *cli.Context, verbose bool, timeout int64) (bool, error) {
+func waitEthClientSynced(ctx context.Context, c *cli.Context, verbose bool, timeout int64) (bool, error) {
 
 	// Prevent multiple waiting goroutines from requesting sync progress
 	ethClientSyncLock.Lock()
@@ -403,7 +413,7 @@ func waitEthClientSynced(c *cli.Context, verbose bool, timeout int64) (bool, err
 		}
 
 		// Get sync progress
-		progress, err := clientToCheck.SyncProgress(context.Background())
+		progress, err := clientToCheck.SyncProgress(ctx)
 		if err != nil {
 			return false, err
 		}
@@ -432,7 +442,11 @@ func waitEthClientSynced(c *cli.Context, verbose bool, timeout int64) (bool, err
 		}
 
 		// Pause before next poll
-		time.Sleep(ethClientSyncPollInterval)
+		select {
+		case <-ctx.Done():
+			return false, fmt.Errorf("stopped waiting for the execution client to sync: %w", ctx.Err())
+		case <-time.After(ethClientSyncPollInterval):
+		}
 
 	}
 
@@ -442,7 +456,7 @@ func waitEthClientSynced(c *cli.Context, verbose bool, timeout int64) (bool, err
 // timeout of 0 indicates no timeout
 var beaconClientSyncLock sync.Mutex
 
-func waitBeaconClientSynced(c *cli.Context, verbose bool, timeout int64) (bool, error) {
+func waitBeaconClientSynced(ctx context.Context, c *cli.Context, verbose bool, timeout int64) (bool, error) {
 
 	// Prevent multiple waiting goroutines from requesting sync progress
 	beaconClientSyncLock.Lock()
@@ -505,7 +519,11 @@ func waitBeaconClientSynced(c *cli.Context, verbose bool, timeout int64) (bool,
 		}
 
 		// Pause before next poll
-		time.Sleep(beaconClientSyncPollInterval)
+		select {
+		case <-ctx.Done():
+			return false, fmt.Errorf("stopped waiting for the consensus client to sync: %w", ctx.Err())
+		case <-time.After(beaconClientSyncPollInterval):
+		}
 
 	}
 
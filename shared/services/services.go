@@ -25,6 +25,7 @@ import (
 	stader_config "github.com/stader-labs/stader-node/stader-lib/stader-config"
 	"math/big"
 	"os"
+	"path/filepath"
 	"sync"
 
 	"github.com/docker/docker/client"
@@ -33,12 +34,20 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/keymanager"
 	"github.com/stader-labs/stader-node/shared/services/passwords"
+	"github.com/stader-labs/stader-node/shared/services/presign"
+	"github.com/stader-labs/stader-node/shared/services/signer"
+	"github.com/stader-labs/stader-node/shared/services/txs"
 	"github.com/stader-labs/stader-node/shared/services/wallet"
+	"github.com/stader-labs/stader-node/shared/services/wallet/external"
 	lhkeystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore/lighthouse"
 	nmkeystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore/nimbus"
 	prkeystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore/prysm"
 	tkkeystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore/teku"
+	w3skeystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore/web3signer"
+	"github.com/stader-labs/stader-node/shared/services/web3signer"
+	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
 	staderUtils "github.com/stader-labs/stader-node/shared/utils/stdr"
 )
 
@@ -56,6 +65,9 @@ var (
 	ecManager       *ExecutionClientManager
 	bcManager       *BeaconClientManager
 	docker          *client.Client
+	presignLedger   *presign.Ledger
+	exitStore       *presign.ExitStore
+	txStore         *txs.Store
 
 	initCfg             sync.Once
 	initPasswordManager sync.Once
@@ -63,6 +75,9 @@ var (
 	initECManager       sync.Once
 	initBCManager       sync.Once
 	initDocker          sync.Once
+	initPresignLedger   sync.Once
+	initExitStore       sync.Once
+	initTxStore         sync.Once
 )
 
 //
@@ -431,6 +446,80 @@ func GetDocker(c *cli.Context) (*client.Client, error) {
 	return getDocker()
 }
 
+func GetPresignLedger(c *cli.Context) (*presign.Ledger, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return getPresignLedger(cfg)
+}
+
+func GetTransactionStore(c *cli.Context) (*txs.Store, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return getTransactionStore(cfg)
+}
+
+func GetPresignedExitStore(c *cli.Context) (*presign.ExitStore, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return getPresignedExitStore(cfg)
+}
+
+// Get a client for the validator client's Keymanager API.
+// The token is read on every call since the validator client regenerates it on startup.
+func GetKeymanagerClient(c *cli.Context) (*keymanager.Client, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.EnableKeymanagerApi.Value != true {
+		return nil, fmt.Errorf("the validator client Keymanager API is disabled")
+	}
+	apiUrl, tokenPath, err := cfg.GetKeymanagerApiInfo()
+	if err != nil {
+		return nil, err
+	}
+	token, err := keymanager.ReadToken(filepath.Join(os.ExpandEnv(cfg.StaderNode.GetValidatorKeychainPath()), tokenPath))
+	if err != nil {
+		return nil, err
+	}
+	return keymanager.NewClient(apiUrl, token), nil
+}
+
+// Get a client for the Web3Signer remote signer
+func GetWeb3SignerClient(c *cli.Context) (*web3signer.Client, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return getWeb3SignerClient(cfg)
+}
+
+// Get the signer for the node's validator keys, either the node wallet or the Web3Signer
+func GetValidatorSigner(c *cli.Context) (signer.ValidatorSigner, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.EnableWeb3Signer.Value == true {
+		client, err := getWeb3SignerClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return signer.NewWeb3Signer(client), nil
+	}
+	w, err := getWallet(c, cfg, getPasswordManager(cfg))
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewLocalSigner(w), nil
+}
+
 //
 // Service instance getters
 //
@@ -482,6 +571,43 @@ func getWallet(c *cli.Context, cfg *config.StaderConfig, pm *passwords.PasswordM
 			return
 		}
 
+		// Hand the node account over to the external signer
+		if signerType := cfg.StaderNode.NodeSignerType.Value.(cfgtypes.NodeSignerType); signerType != cfgtypes.NodeSignerType_Local {
+			address := cfg.StaderNode.NodeSignerAddress.Value.(string)
+			if !common.IsHexAddress(address) {
+				err = fmt.Errorf("invalid external node signer address '%s'", address)
+				return
+			}
+			if signerType == cfgtypes.NodeSignerType_Offline {
+				nodeWallet.SetNodeSigner(external.NewOfflineSigner(common.HexToAddress(address)))
+			} else {
+				var nodeSigner *external.Signer
+				nodeSigner, err = external.NewSigner(signerType, os.ExpandEnv(cfg.StaderNode.NodeSignerUrl.Value.(string)), common.HexToAddress(address))
+				if err != nil {
+					return
+				}
+				nodeWallet.SetNodeSigner(nodeSigner)
+			}
+		}
+
+		// Keep the validator keys in the Web3Signer instead of the validator client folder
+		if cfg.EnableWeb3Signer.Value == true {
+			var client *web3signer.Client
+			client, err = getWeb3SignerClient(cfg)
+			if err != nil {
+				return
+			}
+			// Lighthouse can't find Web3Signer keys by itself, so they are loaded through its Keymanager API
+			var getKeymanager w3skeystore.KeymanagerGetter
+			if cc, _ := cfg.GetSelectedConsensusClient(); cc == cfgtypes.ConsensusClient_Lighthouse {
+				getKeymanager = func() (*keymanager.Client, error) {
+					return GetKeymanagerClient(c)
+				}
+			}
+			nodeWallet.AddKeystore("web3signer", w3skeystore.NewKeystore(client, cfg.Web3SignerImportKeys.Value == true, getKeymanager))
+			return
+		}
+
 		// Keystores
 		lighthouseKeystore := lhkeystore.NewKeystore(os.ExpandEnv(cfg.StaderNode.GetValidatorKeychainPath()), pm)
 		nimbusKeystore := nmkeystore.NewKeystore(os.ExpandEnv(cfg.StaderNode.GetValidatorKeychainPath()), pm)
@@ -501,6 +627,9 @@ func getEthClient(c *cli.Context, cfg *config.StaderConfig) (*ExecutionClientMan
 		// Create a new client manager
 		ecManager, err = NewExecutionClientManager(cfg)
 		if err == nil {
+			// Batch contract reads through the network's Multicall3, if it has one
+			stader.SetMulticall3Address(cfg.StaderNode.GetMulticall3Address())
+
 			// Check if the manager should ignore sync checks and/or default to using the fallback (used by the API container when driven by the CLI)
 			if c.GlobalBool("ignore-sync-check") {
 				ecManager.ignoreSyncCheck = true
@@ -508,6 +637,14 @@ func getEthClient(c *cli.Context, cfg *config.StaderConfig) (*ExecutionClientMan
 			if c.GlobalBool("force-fallbacks") {
 				ecManager.primaryReady = false
 			}
+
+			// Record the transactions sent by the node wallet; sending doesn't depend on it
+			store, storeErr := getTransactionStore(cfg)
+			if storeErr != nil {
+				ecManager.logger.Printlnf("WARNING: Transactions will not be recorded: %s", storeErr.Error())
+			} else {
+				ecManager.SetTransactionStore(store, c.Command.FullName())
+			}
 		}
 	})
 	return ecManager, err
@@ -538,3 +675,35 @@ func getDocker() (*client.Client, error) {
 	})
 	return docker, err
 }
+
+func getPresignLedger(cfg *config.StaderConfig) (*presign.Ledger, error) {
+	var err error
+	initPresignLedger.Do(func() {
+		presignLedger, err = presign.NewLedger(os.ExpandEnv(cfg.StaderNode.GetPresignLedgerPath(true)))
+	})
+	return presignLedger, err
+}
+
+func getTransactionStore(cfg *config.StaderConfig) (*txs.Store, error) {
+	var err error
+	initTxStore.Do(func() {
+		txStore, err = txs.NewStore(os.ExpandEnv(cfg.StaderNode.GetTransactionStorePath(true)))
+	})
+	return txStore, err
+}
+
+func getPresignedExitStore(cfg *config.StaderConfig) (*presign.ExitStore, error) {
+	var err error
+	initExitStore.Do(func() {
+		exitStore, err = presign.NewExitStore(os.ExpandEnv(cfg.StaderNode.GetPresignedExitsPath(true)))
+	})
+	return exitStore, err
+}
+
+func getWeb3SignerClient(cfg *config.StaderConfig) (*web3signer.Client, error) {
+	url := cfg.Web3SignerUrl.Value.(string)
+	if url == "" {
+		return nil, fmt.Errorf("the Web3Signer URL is not set")
+	}
+	return web3signer.NewClient(url), nil
+}
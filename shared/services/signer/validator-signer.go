@@ -0,0 +1,93 @@
+package signer
+
+import (
+	"fmt"
+
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	"github.com/stader-labs/stader-node/shared/services/web3signer"
+	"github.com/stader-labs/stader-node/shared/utils/eth2"
+	"github.com/stader-labs/stader-node/shared/utils/validator"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Signs messages with the node's validator keys
+type ValidatorSigner interface {
+	// Check that the signer holds the key of a validator
+	CheckValidatorKey(pubkey types.ValidatorPubkey) error
+
+	// Sign a voluntary exit message for an epoch, using the fork version the network's schedule gives for it
+	SignVoluntaryExit(pubkey types.ValidatorPubkey, validatorIndex uint64, epoch uint64, schedule eth2.ForkSchedule) (types.ValidatorSignature, error)
+}
+
+// Signs with the validator keys derived from the node wallet
+type LocalSigner struct {
+	w *wallet.Wallet
+}
+
+// Create a signer for the validator keys of the node wallet
+func NewLocalSigner(w *wallet.Wallet) *LocalSigner {
+	return &LocalSigner{
+		w: w,
+	}
+}
+
+func (s *LocalSigner) CheckValidatorKey(pubkey types.ValidatorPubkey) error {
+	_, err := s.w.GetValidatorKeyByPubkey(pubkey)
+	return err
+}
+
+func (s *LocalSigner) SignVoluntaryExit(pubkey types.ValidatorPubkey, validatorIndex uint64, epoch uint64, schedule eth2.ForkSchedule) (types.ValidatorSignature, error) {
+	validatorKey, err := s.w.GetValidatorKeyByPubkey(pubkey)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	signature, _, err := validator.GetSignedExitMessage(validatorKey, validatorIndex, epoch, schedule.VoluntaryExitDomain(epoch))
+	return signature, err
+}
+
+// Signs with validator keys held by a Web3Signer, so the BLS secrets never enter the node
+type Web3Signer struct {
+	client *web3signer.Client
+}
+
+// Create a signer for the validator keys held by a Web3Signer
+func NewWeb3Signer(client *web3signer.Client) *Web3Signer {
+	return &Web3Signer{
+		client: client,
+	}
+}
+
+func (s *Web3Signer) CheckValidatorKey(pubkey types.ValidatorPubkey) error {
+	loaded, err := s.client.HasPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if !loaded {
+		return fmt.Errorf("validator key %s is not loaded in the Web3Signer at %s", pubkey.Hex(), s.client.GetProviderAddress())
+	}
+	return nil
+}
+
+func (s *Web3Signer) SignVoluntaryExit(pubkey types.ValidatorPubkey, validatorIndex uint64, epoch uint64, schedule eth2.ForkSchedule) (types.ValidatorSignature, error) {
+	forkVersion := schedule.VoluntaryExitForkVersion(epoch)
+	domain := schedule.VoluntaryExitDomainForVersion(forkVersion)
+	signingRoot, err := validator.GetExitMessageSigningRoot(validatorIndex, epoch, domain)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+
+	signature, err := s.client.SignVoluntaryExit(pubkey, validatorIndex, epoch, forkVersion, schedule.GenesisValidatorsRoot, signingRoot)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+
+	// Make sure the Web3Signer signed the exact message, a bad exit signature would only be noticed when it is needed
+	valid, err := validator.VerifyExitMessage(pubkey, validatorIndex, epoch, domain, signature)
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("could not verify the Web3Signer exit signature of validator %s: %w", pubkey.Hex(), err)
+	}
+	if !valid {
+		return types.ValidatorSignature{}, fmt.Errorf("the Web3Signer returned an invalid exit signature for validator %s", pubkey.Hex())
+	}
+	return signature, nil
+}
@@ -49,6 +49,7 @@ import (
 
 	"github.com/stader-labs/stader-node/shared/services/config"
 	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
+	"github.com/stader-labs/stader-node/shared/utils/slashing"
 	staderUtils "github.com/stader-labs/stader-node/shared/utils/stdr"
 )
 
@@ -112,6 +113,7 @@ type Client struct {
 	debugPrint         bool
 	ignoreSyncCheck    bool
 	forceFallbacks     bool
+	unsignedTx         bool
 }
 
 // Create new Stader client from CLI context
@@ -906,6 +908,35 @@ func (c *Client) RunEcMigrator(container string, volume string, targetDir string
 	return nil
 }
 
+// Runs a validator client's slashing protection export tool on the validators folder and copies the exported file to the target path
+func (c *Client) RunSlashingProtectionExport(container string, image string, validatorsDir string, tool slashing.ToolCommand, targetFile string) error {
+	cmd := fmt.Sprintf("docker run --name %s --user root -v %s:%s %s",
+		shellescape.Quote(container),
+		shellescape.Quote(validatorsDir), slashing.ValidatorsMountPath,
+		getToolArgs(image, tool))
+	err := c.printOutput(cmd)
+	if err == nil {
+		cmd = fmt.Sprintf("docker cp %s %s", shellescape.Quote(container+":"+slashing.ExportFilePath), shellescape.Quote(targetFile))
+		_, err = c.readOutput(cmd)
+	}
+
+	// Remove the tool container even if the export failed
+	if _, rmErr := c.RemoveContainer(container); rmErr != nil && err == nil {
+		err = fmt.Errorf("Error removing container [%s]: %w", container, rmErr)
+	}
+	return err
+}
+
+// Runs a validator client's slashing protection import tool on the validators folder, reading the interchange file from the given folder
+func (c *Client) RunSlashingProtectionImport(container string, image string, validatorsDir string, interchangeDir string, tool slashing.ToolCommand) error {
+	cmd := fmt.Sprintf("docker run --rm --name %s --user root -v %s:%s -v %s:%s:ro %s",
+		shellescape.Quote(container),
+		shellescape.Quote(validatorsDir), slashing.ValidatorsMountPath,
+		shellescape.Quote(interchangeDir), slashing.InterchangeMountPath,
+		getToolArgs(image, tool))
+	return c.printOutput(cmd)
+}
+
 // Gets the size of the target directory via the EC migrator for importing, which should have the same permissions as exporting
 func (c *Client) GetDirSizeViaEcMigrator(container string, targetDir string, image string) (uint64, error) {
 	cmd := fmt.Sprintf("docker run --rm --name %s -v %s:/mnt/external -e OPERATION='size' %s", container, targetDir, image)
@@ -1020,6 +1051,11 @@ func (c *Client) SetClientStatusFlags(ignoreSyncCheck bool, forceFallbacks bool)
 	c.forceFallbacks = forceFallbacks
 }
 
+// Set whether write commands should build unsigned transactions to be signed offline instead of sending them
+func (c *Client) SetUnsignedTx(unsignedTx bool) {
+	c.unsignedTx = unsignedTx
+}
+
 // Get the command used to escalate privileges on the system
 func (c *Client) getEscalationCommand() (string, error) {
 	// Check for sudo first
@@ -1546,15 +1582,16 @@ func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getUnsignedTxFlag(), args)
 	} else {
-		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s %s api %s",
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
 			ignoreSyncCheckFlag,
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getUnsignedTxFlag(),
 			args)
 	}
 
@@ -1579,13 +1616,13 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getUnsignedTxFlag(), args)
 	} else {
 		envArgs := ""
 		for key, value := range envVars {
 			envArgs += fmt.Sprintf("%s=%s ", key, shellescape.Quote(value))
 		}
-		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s %s api %s",
 			envArgs,
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
@@ -1593,6 +1630,7 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getUnsignedTxFlag(),
 			args)
 	}
 
@@ -1684,6 +1722,13 @@ func (c *Client) getCustomNonce() string {
 	return nonce
 }
 
+func (c *Client) getUnsignedTxFlag() string {
+	if c.unsignedTx {
+		return "--unsigned-tx"
+	}
+	return ""
+}
+
 // Get the first downloader available to the system
 func (c *Client) getDownloader() (string, error) {
 
@@ -1770,3 +1815,12 @@ func (c *Client) readOutput(cmdText string) ([]byte, error) {
 	return cmd.Output()
 
 }
+
+// Get the image, entrypoint and arguments of a tool container
+func getToolArgs(image string, tool slashing.ToolCommand) string {
+	args := make([]string, len(tool.Args))
+	for i, arg := range tool.Args {
+		args[i] = shellescape.Quote(arg)
+	}
+	return fmt.Sprintf("--entrypoint %s %s %s", shellescape.Quote(tool.Entrypoint), shellescape.Quote(image), strings.Join(args, " "))
+}
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	string_utils "github.com/stader-labs/stader-node/shared/utils/string-utils"
 	"github.com/stader-labs/stader-node/stader-lib/types"
 	"math/big"
@@ -33,7 +34,19 @@ import (
 
 // Get node status
 func (c *Client) NodeStatus() (api.NodeStatusResponse, error) {
-	responseBytes, err := c.callAPI("node status")
+	return c.NodeStatusAt(0, 0)
+}
+
+// Get the node status as of an EL block or a Beacon slot, or at the head if both are 0
+func (c *Client) NodeStatusAt(blockNumber uint64, slotNumber uint64) (api.NodeStatusResponse, error) {
+	command := "node status"
+	if blockNumber != 0 {
+		command += fmt.Sprintf(" --block %d", blockNumber)
+	}
+	if slotNumber != 0 {
+		command += fmt.Sprintf(" --slot %d", slotNumber)
+	}
+	responseBytes, err := c.callAPI(command)
 	if err != nil {
 		return api.NodeStatusResponse{}, fmt.Errorf("could not get node status: %w", err)
 	}
@@ -251,6 +264,64 @@ func (c *Client) ExitValidator(validatorPubKey types.ValidatorPubkey) (api.ExitV
 	return response, nil
 }
 
+// Get the presigned exit message status of the operator's validators
+func (c *Client) PresignStatus() (api.PresignStatusResponse, error) {
+	responseBytes, err := c.callAPI("validator presign-status")
+	if err != nil {
+		return api.PresignStatusResponse{}, fmt.Errorf("could not get presign-status: %w", err)
+	}
+	var response api.PresignStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PresignStatusResponse{}, fmt.Errorf("could not decode presign-status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PresignStatusResponse{}, fmt.Errorf("could not get presign-status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Verify the locally stored presigned exit messages
+func (c *Client) VerifyExits() (api.VerifyExitsResponse, error) {
+	responseBytes, err := c.callAPI("validator verify-exits")
+	if err != nil {
+		return api.VerifyExitsResponse{}, fmt.Errorf("could not verify presigned exits: %w", err)
+	}
+	var response api.VerifyExitsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.VerifyExitsResponse{}, fmt.Errorf("could not decode verify-exits response: %w", err)
+	}
+	if response.Error != "" {
+		return api.VerifyExitsResponse{}, fmt.Errorf("could not verify presigned exits: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the consensus performance of the operator's validators over an epoch range, a start or end epoch of 0 uses the default range
+func (c *Client) ValidatorPerformance(startEpoch uint64, endEpoch uint64) (api.ValidatorPerformanceResponse, error) {
+	command := "validator performance"
+	if startEpoch != 0 {
+		command += fmt.Sprintf(" --start-epoch %d", startEpoch)
+	}
+	if endEpoch != 0 {
+		command += fmt.Sprintf(" --end-epoch %d", endEpoch)
+	}
+	responseBytes, err := c.callAPI(command)
+	if err != nil {
+		return api.ValidatorPerformanceResponse{}, fmt.Errorf("could not get validator performance: %w", err)
+	}
+	var response api.ValidatorPerformanceResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ValidatorPerformanceResponse{}, fmt.Errorf("could not decode validator performance response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ValidatorPerformanceResponse{}, fmt.Errorf("could not get validator performance: %s", response.Error)
+	}
+	if response.MissedAttestationPenaltyPerStrike == nil {
+		response.MissedAttestationPenaltyPerStrike = big.NewInt(0)
+	}
+	return response, nil
+}
+
 func (c *Client) GetContractsInfo() (api.ContractsInfoResponse, error) {
 	responseBytes, err := c.callAPI("node get-contracts-info")
 	if err != nil {
@@ -314,6 +385,118 @@ func (c *Client) NodeSend(amountWei *big.Int, token string, toAddress common.Add
 	return response, nil
 }
 
+// Get the pending transactions sent by the node wallet
+func (c *Client) GetPendingTransactions() (api.PendingTransactionsResponse, error) {
+	responseBytes, err := c.callAPI("node list-transactions")
+	if err != nil {
+		return api.PendingTransactionsResponse{}, fmt.Errorf("could not get pending transactions: %w", err)
+	}
+	var response api.PendingTransactionsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PendingTransactionsResponse{}, fmt.Errorf("could not decode list transactions response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PendingTransactionsResponse{}, fmt.Errorf("could not get pending transactions: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the latest contract events indexed for the node
+func (c *Client) GetNodeEvents(limit uint64) (api.NodeEventsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node list-events %d", limit))
+	if err != nil {
+		return api.NodeEventsResponse{}, fmt.Errorf("could not get node events: %w", err)
+	}
+	var response api.NodeEventsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeEventsResponse{}, fmt.Errorf("could not decode node events response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeEventsResponse{}, fmt.Errorf("could not get node events: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether a pending transaction can be sent again with higher fees
+func (c *Client) CanSpeedUpTransaction(hash common.Hash) (api.CanReplaceTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node can-speed-up-transaction %s", hash.Hex()))
+	if err != nil {
+		return api.CanReplaceTransactionResponse{}, fmt.Errorf("could not get can speed up transaction status: %w", err)
+	}
+	var response api.CanReplaceTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanReplaceTransactionResponse{}, fmt.Errorf("could not decode can speed up transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanReplaceTransactionResponse{}, fmt.Errorf("could not get can speed up transaction status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Send a pending transaction again with higher fees
+func (c *Client) SpeedUpTransaction(hash common.Hash) (api.ReplaceTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node speed-up-transaction %s", hash.Hex()))
+	if err != nil {
+		return api.ReplaceTransactionResponse{}, fmt.Errorf("could not speed up transaction: %w", err)
+	}
+	var response api.ReplaceTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ReplaceTransactionResponse{}, fmt.Errorf("could not decode speed up transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ReplaceTransactionResponse{}, fmt.Errorf("could not speed up transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether a pending transaction can be cancelled
+func (c *Client) CanCancelTransaction(hash common.Hash) (api.CanReplaceTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node can-cancel-transaction %s", hash.Hex()))
+	if err != nil {
+		return api.CanReplaceTransactionResponse{}, fmt.Errorf("could not get can cancel transaction status: %w", err)
+	}
+	var response api.CanReplaceTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanReplaceTransactionResponse{}, fmt.Errorf("could not decode can cancel transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanReplaceTransactionResponse{}, fmt.Errorf("could not get can cancel transaction status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Cancel a pending transaction
+func (c *Client) CancelTransaction(hash common.Hash) (api.ReplaceTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node cancel-transaction %s", hash.Hex()))
+	if err != nil {
+		return api.ReplaceTransactionResponse{}, fmt.Errorf("could not cancel transaction: %w", err)
+	}
+	var response api.ReplaceTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ReplaceTransactionResponse{}, fmt.Errorf("could not decode cancel transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ReplaceTransactionResponse{}, fmt.Errorf("could not cancel transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Send a transaction signed offline for the node account
+func (c *Client) BroadcastTx(rawTx []byte) (api.BroadcastTxResponse, error) {
+	responseBytes, err := c.callAPI("node broadcast", hexutil.Encode(rawTx))
+	if err != nil {
+		return api.BroadcastTxResponse{}, fmt.Errorf("could not broadcast transaction: %w", err)
+	}
+	var response api.BroadcastTxResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BroadcastTxResponse{}, fmt.Errorf("could not decode broadcast transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BroadcastTxResponse{}, fmt.Errorf("could not broadcast transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get node sync progress
 func (c *Client) NodeSync() (api.NodeSyncProgressResponse, error) {
 	responseBytes, err := c.callAPI("node sync")
@@ -427,6 +610,22 @@ func (c *Client) CanSendClRewards(validatorPubKey types.ValidatorPubkey) (api.Ca
 	return response, nil
 }
 
+func (c *Client) CanSendAllClRewards(minimum *big.Int) (api.CanSendAllClRewardsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("validator can-send-all-cl-rewards %s", minimum.String()))
+	if err != nil {
+		return api.CanSendAllClRewardsResponse{}, fmt.Errorf("could not get validator can-send-all-cl-rewards response: %w", err)
+	}
+	var response api.CanSendAllClRewardsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanSendAllClRewardsResponse{}, fmt.Errorf("could not decode validator can-send-all-cl-rewards response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanSendAllClRewardsResponse{}, fmt.Errorf("could not get validator can-send-all-cl-rewards response: %s", response.Error)
+	}
+
+	return response, nil
+}
+
 func (c *Client) SendClRewards(validatorPubKey types.ValidatorPubkey) (api.SendClRewardsResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("validator send-cl-rewards %s", validatorPubKey))
 	if err != nil {
@@ -59,3 +59,19 @@ func (c *Client) GetClientStatus() (api.ClientStatusResponse, error) {
 	}
 	return response, nil
 }
+
+// Get max fee and priority fee suggestions from the fee history of recent blocks
+func (c *Client) GetGasPrices() (api.GasPricesResponse, error) {
+	responseBytes, err := c.callAPI("service get-gas-prices")
+	if err != nil {
+		return api.GasPricesResponse{}, fmt.Errorf("Could not get gas prices: %w", err)
+	}
+	var response api.GasPricesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GasPricesResponse{}, fmt.Errorf("Could not decode gas prices response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GasPricesResponse{}, fmt.Errorf("Could not get gas prices: %s", response.Error)
+	}
+	return response, nil
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/stader-labs/stader-node/shared/services/txs"
 	"github.com/stader-labs/stader-node/shared/types/api"
 )
 
@@ -59,6 +60,22 @@ func (c *Client) SetPassword(password string) (api.SetPasswordResponse, error) {
 	return response, nil
 }
 
+// Change wallet password
+func (c *Client) ChangePassword(currentPassword string, newPassword string) (api.ChangePasswordResponse, error) {
+	responseBytes, err := c.callAPI("wallet change-password", currentPassword, newPassword)
+	if err != nil {
+		return api.ChangePasswordResponse{}, fmt.Errorf("Could not change wallet password: %w", err)
+	}
+	var response api.ChangePasswordResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ChangePasswordResponse{}, fmt.Errorf("Could not decode change wallet password response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ChangePasswordResponse{}, fmt.Errorf("Could not change wallet password: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Initialize wallet
 func (c *Client) InitWallet(derivationPath string) (api.InitWalletResponse, error) {
 	responseBytes, err := c.callAPI("wallet init --derivation-path", derivationPath)
@@ -183,6 +200,38 @@ func (c *Client) RebuildWallet() (api.RebuildWalletResponse, error) {
 	return response, nil
 }
 
+// Import validator keys from EIP-2335 keystores encrypted with the same password
+func (c *Client) ImportKeystores(password string, keystores []string) (api.ImportKeystoresResponse, error) {
+	responseBytes, err := c.callAPI("wallet import-keystores", append([]string{password}, keystores...)...)
+	if err != nil {
+		return api.ImportKeystoresResponse{}, fmt.Errorf("Could not import keystores: %w", err)
+	}
+	var response api.ImportKeystoresResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ImportKeystoresResponse{}, fmt.Errorf("Could not decode import keystores response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ImportKeystoresResponse{}, fmt.Errorf("Could not import keystores: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Load the held imported validator keys whose slashing protection history is in the validator client into it
+func (c *Client) ReleaseImportedKeys() (api.ReleaseImportedKeysResponse, error) {
+	responseBytes, err := c.callAPI("wallet release-imported-keys")
+	if err != nil {
+		return api.ReleaseImportedKeysResponse{}, fmt.Errorf("Could not release imported keys: %w", err)
+	}
+	var response api.ReleaseImportedKeysResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ReleaseImportedKeysResponse{}, fmt.Errorf("Could not decode release imported keys response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ReleaseImportedKeysResponse{}, fmt.Errorf("Could not release imported keys: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Purge the node wallet and validator keys
 func (c *Client) Purge() (api.PurgeResponse, error) {
 	responseBytes, err := c.callAPI("wallet purge")
@@ -246,3 +295,23 @@ func (c *Client) ExportWallet() (api.ExportWalletResponse, error) {
 	}
 	return response, nil
 }
+
+// Sign an unsigned transaction built for the node account
+func (c *Client) SignTx(unsignedTx *txs.UnsignedTransaction) (api.SignTxResponse, error) {
+	unsignedTxJson, err := json.Marshal(unsignedTx)
+	if err != nil {
+		return api.SignTxResponse{}, fmt.Errorf("Could not encode unsigned transaction: %w", err)
+	}
+	responseBytes, err := c.callAPI("wallet sign-tx", string(unsignedTxJson))
+	if err != nil {
+		return api.SignTxResponse{}, fmt.Errorf("Could not sign transaction: %w", err)
+	}
+	var response api.SignTxResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SignTxResponse{}, fmt.Errorf("Could not decode sign transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SignTxResponse{}, fmt.Errorf("Could not sign transaction: %s", response.Error)
+	}
+	return response, nil
+}
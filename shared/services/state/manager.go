@@ -80,12 +80,37 @@ func (m *MetricsCacheManager) GetHeadState(nodeAddress common.Address) (*Metrics
 	return m.getNodeMetrics(nodeAddress, targetSlot)
 }
 
+// Get the node's metrics at the head slot, or at the finalized slot if the node is configured to use it
 func (m *MetricsCacheManager) GetHeadStateForNode(nodeAddress common.Address) (*MetricsCache, error) {
-	targetSlot, err := m.GetHeadSlot()
+	var snapshot Snapshot
+	var err error
+	if m.cfg.StaderNode.UseFinalizedMetricsSnapshot.Value == true {
+		snapshot, err = GetFinalizedSnapshot(m.bc)
+	} else {
+		snapshot, err = GetHeadSnapshot(m.bc)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("error getting latest Beacon slot: %w", err)
+		return nil, fmt.Errorf("error getting the metrics snapshot: %w", err)
 	}
-	return m.getNodeMetrics(nodeAddress, targetSlot)
+	return m.getNodeMetricsAt(nodeAddress, snapshot)
+}
+
+// Get the node's metrics as they were at a Beacon slot
+func (m *MetricsCacheManager) GetStateForNodeAtSlot(nodeAddress common.Address, slot uint64) (*MetricsCache, error) {
+	snapshot, err := GetSnapshotAtSlot(m.bc, slot)
+	if err != nil {
+		return nil, err
+	}
+	return m.getNodeMetricsAt(nodeAddress, snapshot)
+}
+
+// Get the node's metrics as they were at an EL block
+func (m *MetricsCacheManager) GetStateForNodeAtBlock(nodeAddress common.Address, blockNumber uint64) (*MetricsCache, error) {
+	snapshot, err := GetSnapshotAtBlock(m.ec, m.bc, m.BeaconConfig, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return m.getNodeMetricsAt(nodeAddress, snapshot)
 }
 
 func (m *MetricsCacheManager) GetHeadSlot() (uint64, error) {
@@ -104,7 +129,15 @@ func (m *MetricsCacheManager) GetHeadSlot() (uint64, error) {
 }
 
 func (m *MetricsCacheManager) getNodeMetrics(nodeAddress common.Address, slotNumber uint64) (*MetricsCache, error) {
-	state, err := CreateMetricsCache(m.c, m.cfg.StaderNode, m.ec, m.bc, m.log, slotNumber, m.BeaconConfig, nodeAddress)
+	snapshot, err := GetSnapshotAtSlot(m.bc, slotNumber)
+	if err != nil {
+		return nil, err
+	}
+	return m.getNodeMetricsAt(nodeAddress, snapshot)
+}
+
+func (m *MetricsCacheManager) getNodeMetricsAt(nodeAddress common.Address, snapshot Snapshot) (*MetricsCache, error) {
+	state, err := CreateMetricsCache(m.c, m.cfg.StaderNode, m.ec, m.bc, m.log, snapshot, m.BeaconConfig, nodeAddress)
 	if err != nil {
 		return nil, err
 	}
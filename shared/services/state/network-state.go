@@ -21,6 +21,7 @@ import (
 
 	"github.com/stader-labs/stader-node/shared/services/beacon"
 	"github.com/stader-labs/stader-node/shared/services/config"
+	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
 	"github.com/stader-labs/stader-node/shared/utils/log"
 	"github.com/stader-labs/stader-node/stader-lib/node"
 	sd_collateral "github.com/stader-labs/stader-node/stader-lib/sd-collateral"
@@ -28,6 +29,7 @@ import (
 	"github.com/stader-labs/stader-node/stader-lib/tokens"
 	"github.com/stader-labs/stader-node/stader-lib/types"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -132,7 +134,7 @@ func CreateMetricsCache(
 	ec stader.ExecutionClient,
 	bc beacon.Client,
 	log *log.ColorLogger,
-	slotNumber uint64,
+	snapshot Snapshot,
 	beaconConfig beacon.Eth2Config,
 	nodeAddress common.Address,
 ) (*MetricsCache, error) {
@@ -207,76 +209,67 @@ func CreateMetricsCache(
 		return nil, err
 	}
 
-	// Get the execution block for the given slot
-	beaconBlock, exists, err := bc.GetBeaconBlock(fmt.Sprintf("%d", slotNumber))
-	if err != nil {
-		return nil, fmt.Errorf("error getting Beacon block for slot %d: %w", slotNumber, err)
-	}
-	if !exists {
-		return nil, fmt.Errorf("slot %d did not have a Beacon block", slotNumber)
-	}
-
-	// Get the corresponding block on the EL
-	elBlockNumber := beaconBlock.ExecutionBlockNumber
-
 	// Create the state wrapper
 	state := &MetricsCache{
-		BeaconSlotNumber: slotNumber,
-		ElBlockNumber:    elBlockNumber,
+		BeaconSlotNumber: snapshot.BeaconSlot,
+		ElBlockNumber:    snapshot.ElBlockNumber,
 		BeaconConfig:     beaconConfig,
 		log:              log,
 	}
 
-	state.logLine("Getting network state for EL block %d, Beacon slot %d", elBlockNumber, slotNumber)
+	state.logLine("Getting network state for EL block %d, Beacon slot %d", snapshot.ElBlockNumber, snapshot.BeaconSlot)
 
 	start := time.Now()
 
+	// Take every contract read at the snapshot's EL block
+	opts := snapshot.CallOpts()
+
 	// fetch all validator pub keys
-	operatorId, err := node.GetOperatorId(prn, nodeAddress, nil)
+	operatorId, err := node.GetOperatorId(prn, nodeAddress, opts)
 	if err != nil {
 		return nil, err
 	}
-	operatorElRewardAddress, err := node.GetNodeElRewardAddress(prn, 1, operatorId, nil)
+	operatorElRewardAddress, err := node.GetNodeElRewardAddress(prn, 1, operatorId, opts)
 	if err != nil {
 		return nil, err
 	}
-	elRewardAddressBalance, err := tokens.GetEthBalance(prn.Client, operatorElRewardAddress, nil)
+	elRewardAddressBalance, err := tokens.GetEthBalance(prn.Client, operatorElRewardAddress, opts)
 	if err != nil {
 		return nil, err
 	}
-	operatorElRewards, err := pool_utils.CalculateRewardShare(putils, 1, elRewardAddressBalance, nil)
+	operatorElRewards, err := pool_utils.CalculateRewardShare(putils, 1, elRewardAddressBalance, opts)
 	if err != nil {
 		return nil, err
 	}
-	operatorSdColletaral, err := sd_collateral.GetOperatorSdBalance(sdc, nodeAddress, nil)
+	operatorSdColletaral, err := sd_collateral.GetOperatorSdBalance(sdc, nodeAddress, opts)
 	if err != nil {
 		return nil, err
 	}
-	totalValidatorKeys, err := node.GetTotalValidatorKeys(prn, operatorId, nil)
+	totalValidatorKeys, err := node.GetTotalValidatorKeys(prn, operatorId, opts)
 	if err != nil {
 		return nil, err
 	}
-	poolThreshold, err := sd_collateral.GetPoolThreshold(sdc, 1, nil)
+	poolThreshold, err := sd_collateral.GetPoolThreshold(sdc, 1, opts)
 	if err != nil {
 		return nil, err
 	}
-	operatorSdCollateralInEth, err := sd_collateral.ConvertSdToEth(sdc, operatorSdColletaral, nil)
+	operatorSdCollateralInEth, err := sd_collateral.ConvertSdToEth(sdc, operatorSdColletaral, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	operatorNonTerminalKeys, err := node.GetTotalNonTerminalValidatorKeys(prn, nodeAddress, totalValidatorKeys, nil)
+	operatorNonTerminalKeys, err := node.GetTotalNonTerminalValidatorKeys(prn, nodeAddress, totalValidatorKeys, opts)
 	if err != nil {
 		return nil, err
 	}
 	operatorEthCollateral := float64(4 * operatorNonTerminalKeys)
 
-	nextRewardCycleDetails, err := socializing_pool.GetRewardDetails(sp, nil)
+	nextRewardCycleDetails, err := socializing_pool.GetRewardDetails(sp, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	validatorInfoMap, pubkeys, err := stdr.GetAllValidatorsRegisteredWithOperator(prn, operatorId, nodeAddress, nil)
+	validatorInfoMap, pubkeys, err := stdr.GetAllValidatorsRegisteredWithOperator(prn, operatorId, nodeAddress, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -294,19 +287,35 @@ func CreateMetricsCache(
 	cumulativePenalty := big.NewInt(0)
 
 	// Get the validator stats from Beacon
-	statusMap, err := bc.GetValidatorStatuses(pubkeys, &beacon.ValidatorStatusOptions{
-		Slot: &slotNumber,
-	})
+	statusMap, err := bc.GetValidatorStatuses(pubkeys, snapshot.ValidatorStatusOptions())
 	if err != nil {
 		return nil, err
 	}
-	for _, pubKey := range pubkeys {
-		totalValidatorPenalty, err := penalty_tracker.GetCumulativeValidatorPenalty(pt, pubKey, nil)
-		if err != nil {
+	rewardsThreshold, err := stader_config.GetRewardsThreshold(sdcfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	mc, err := stader.NewMultiCaller(ec)
+	if err != nil {
+		return nil, err
+	}
+	validatorPenalties := make([]*big.Int, len(pubkeys))
+	for i, pubKey := range pubkeys {
+		if err := penalty_tracker.AddGetCumulativeValidatorPenaltyCall(mc, pt, pubKey, &validatorPenalties[i]); err != nil {
 			return nil, err
 		}
+	}
+	if err := mc.Execute(opts); err != nil {
+		return nil, err
+	}
+	for _, totalValidatorPenalty := range validatorPenalties {
 		cumulativePenalty.Add(cumulativePenalty, totalValidatorPenalty)
+	}
 
+	// Withdraw vaults of the validators that can hold CL rewards
+	withdrawVaults := []common.Address{}
+	for _, pubKey := range pubkeys {
 		validatorContractInfo, ok := validatorInfoMap[pubKey]
 		if !ok {
 			state.logLine("pub key is not found in validatorInfoMap: %s\n", pubKey)
@@ -361,24 +370,32 @@ func CreateMetricsCache(
 			activeValidators.Add(activeValidators, big.NewInt(1))
 		}
 
-		validatorWithdrawVault := validatorInfoMap[pubKey].WithdrawVaultAddress
-		withdrawVaultBalance, err := tokens.GetEthBalance(prn.Client, validatorWithdrawVault, nil)
-		if err != nil {
-			return nil, err
-		}
-		withdrawVaultRewardShares, err := pool_utils.CalculateRewardShare(putils, 1, withdrawVaultBalance, nil)
-		if err != nil {
+		withdrawVaults = append(withdrawVaults, validatorInfoMap[pubKey].WithdrawVaultAddress)
+	}
+
+	withdrawVaultBalances := make([]*big.Int, len(withdrawVaults))
+	for i, withdrawVault := range withdrawVaults {
+		if err := tokens.AddGetEthBalanceCall(mc, withdrawVault, &withdrawVaultBalances[i]); err != nil {
 			return nil, err
 		}
-		rewardsThreshold, err := stader_config.GetRewardsThreshold(sdcfg, nil)
-		if err != nil {
+	}
+	if err := mc.Execute(opts); err != nil {
+		return nil, err
+	}
+	withdrawVaultRewardShares := make([]types.RewardShare, len(withdrawVaults))
+	for i := range withdrawVaults {
+		if err := pool_utils.AddCalculateRewardShareCall(mc, putils, 1, withdrawVaultBalances[i], &withdrawVaultRewardShares[i]); err != nil {
 			return nil, err
 		}
-		if withdrawVaultRewardShares.OperatorShare.Cmp(rewardsThreshold) > 0 {
+	}
+	if err := mc.Execute(opts); err != nil {
+		return nil, err
+	}
+	for _, withdrawVaultRewardShare := range withdrawVaultRewardShares {
+		if withdrawVaultRewardShare.OperatorShare.Cmp(rewardsThreshold) > 0 {
 			continue
-		} else {
-			totalClRewards.Add(totalClRewards, withdrawVaultRewardShares.OperatorShare)
 		}
+		totalClRewards.Add(totalClRewards, withdrawVaultRewardShare.OperatorShare)
 	}
 
 	state.ValidatorDetails = statusMap
@@ -389,7 +406,7 @@ func CreateMetricsCache(
 
 	start = time.Now()
 
-	rewardClaimData, err := getClaimedAndUnclaimedSocializingSdAndEth(cfg, sp, nodeAddress)
+	rewardClaimData, err := getClaimedAndUnclaimedSocializingSdAndEth(cfg, sp, nodeAddress, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -402,43 +419,43 @@ func CreateMetricsCache(
 
 	metricsDetails := MetricDetails{}
 
-	sdPrice, err := sd_collateral.ConvertEthToSd(sdc, big.NewInt(1000000000000000000), nil)
+	sdPrice, err := sd_collateral.ConvertEthToSd(sdc, big.NewInt(1000000000000000000), opts)
 	if err != nil {
 		return nil, err
 	}
-	ethPrice, err := sd_collateral.ConvertSdToEth(sdc, big.NewInt(1000000000000000000), nil)
+	ethPrice, err := sd_collateral.ConvertSdToEth(sdc, big.NewInt(1000000000000000000), opts)
 	if err != nil {
 		return nil, err
 	}
-	totalOperators, err := node.GetNextOperatorId(prn, nil)
+	totalOperators, err := node.GetNextOperatorId(prn, opts)
 	if err != nil {
 		return nil, err
 	}
-	totalValidators, err := node.GetNextValidatorId(prn, nil)
+	totalValidators, err := node.GetNextValidatorId(prn, opts)
 	if err != nil {
 		return nil, err
 	}
-	totalActiveValidators, err := node.GetTotalActiveValidators(prn, nil)
+	totalActiveValidators, err := node.GetTotalActiveValidators(prn, opts)
 	if err != nil {
 		return nil, err
 	}
-	totalQueuedValidators, err := node.GetTotalQueuedValidators(prn, nil)
+	totalQueuedValidators, err := node.GetTotalQueuedValidators(prn, opts)
 	if err != nil {
 		return nil, err
 	}
-	totalSdCollateral, err := tokens.BalanceOf(sdt, sdcAddress, nil)
+	totalSdCollateral, err := tokens.BalanceOf(sdt, sdcAddress, opts)
 	if err != nil {
 		return nil, err
 	}
-	permissionlessPoolThreshold, err := sd_collateral.GetPoolThreshold(sdc, 1, nil)
+	permissionlessPoolThreshold, err := sd_collateral.GetPoolThreshold(sdc, 1, opts)
 	if err != nil {
 		return nil, err
 	}
-	ethxSupply, err := tokens.TotalSupply(ethx, nil)
+	ethxSupply, err := tokens.TotalSupply(ethx, opts)
 	if err != nil {
 		return nil, err
 	}
-	totalStakedAssets, err := stake_pool_manager.GetTotalAssets(spm, nil)
+	totalStakedAssets, err := stake_pool_manager.GetTotalAssets(spm, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -503,6 +520,7 @@ func getClaimedAndUnclaimedSocializingSdAndEth(
 	cfg *config.StaderNodeConfig,
 	sp *stader.SocializingPoolContractManager,
 	nodeAccount common.Address,
+	opts *bind.CallOpts,
 ) (struct {
 	unclaimedEth *big.Int
 	unclaimedSd  *big.Int
@@ -526,15 +544,13 @@ func getClaimedAndUnclaimedSocializingSdAndEth(
 	outstruct.claimedEth = big.NewInt(0)
 	outstruct.claimedSd = big.NewInt(0)
 
-	rewardDetails, err := socializing_pool.GetRewardDetails(sp, nil)
+	rewardDetails, err := socializing_pool.GetRewardDetails(sp, opts)
 	if err != nil {
 		return outstruct, err
 	}
 
-	unclaimedEth := big.NewInt(0)
-	unclaimedSd := big.NewInt(0)
-	claimedEth := big.NewInt(0)
-	claimedSd := big.NewInt(0)
+	cachedCycles := []int64{}
+	cachedMerkles := []stader_backend.CycleMerkleProofs{}
 	for i := int64(1); i < rewardDetails.CurrentIndex.Int64(); i++ {
 		cycleMerkleProof, exists, err := cfg.ReadCycleCache(i)
 		if err != nil {
@@ -543,10 +559,30 @@ func getClaimedAndUnclaimedSocializingSdAndEth(
 		if !exists {
 			continue
 		}
-		claimed, err := socializing_pool.HasClaimedRewards(sp, nodeAccount, big.NewInt(i), nil)
-		if err != nil {
+		cachedCycles = append(cachedCycles, i)
+		cachedMerkles = append(cachedMerkles, cycleMerkleProof)
+	}
+
+	mc, err := stader.NewMultiCaller(sp.Client)
+	if err != nil {
+		return outstruct, err
+	}
+	claimedCycles := make([]bool, len(cachedCycles))
+	for i, cycle := range cachedCycles {
+		if err := socializing_pool.AddHasClaimedRewardsCall(mc, sp, nodeAccount, big.NewInt(cycle), &claimedCycles[i]); err != nil {
 			return outstruct, err
 		}
+	}
+	if err := mc.Execute(opts); err != nil {
+		return outstruct, err
+	}
+
+	unclaimedEth := big.NewInt(0)
+	unclaimedSd := big.NewInt(0)
+	claimedEth := big.NewInt(0)
+	claimedSd := big.NewInt(0)
+	for i, cycleMerkleProof := range cachedMerkles {
+		claimed := claimedCycles[i]
 
 		if claimed {
 			ethClaimed, ok := big.NewInt(0).SetString(cycleMerkleProof.Eth, 10)
@@ -0,0 +1,106 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+// How far back to look for a proposed block when the requested slot was missed
+const maxMissedSlots = 32
+
+// The Beacon slot and matching EL block that every read of a snapshot is pinned to
+type Snapshot struct {
+	BeaconSlot    uint64
+	ElBlockNumber uint64
+}
+
+// Call options pinning contract reads to the snapshot's EL block
+func (s Snapshot) CallOpts() *bind.CallOpts {
+	return &bind.CallOpts{BlockNumber: big.NewInt(0).SetUint64(s.ElBlockNumber)}
+}
+
+// Validator status options pinning Beacon queries to the snapshot's slot
+func (s Snapshot) ValidatorStatusOptions() *beacon.ValidatorStatusOptions {
+	slot := s.BeaconSlot
+	return &beacon.ValidatorStatusOptions{Slot: &slot}
+}
+
+// Get a snapshot of the Beacon head
+func GetHeadSnapshot(bc beacon.Client) (Snapshot, error) {
+	return getSnapshotForBlockId(bc, "head")
+}
+
+// Get a snapshot of the latest finalized Beacon block
+func GetFinalizedSnapshot(bc beacon.Client) (Snapshot, error) {
+	return getSnapshotForBlockId(bc, "finalized")
+}
+
+// Get a snapshot at a Beacon slot. If no block was proposed in the slot, the closest earlier slot with a block is used.
+func GetSnapshotAtSlot(bc beacon.Client, slot uint64) (Snapshot, error) {
+	for i := uint64(0); i <= maxMissedSlots && i <= slot; i++ {
+		beaconBlock, exists, err := bc.GetBeaconBlock(fmt.Sprintf("%d", slot-i))
+		if err != nil {
+			return Snapshot{}, fmt.Errorf("error getting Beacon block for slot %d: %w", slot-i, err)
+		}
+		if !exists {
+			continue
+		}
+		if !beaconBlock.HasExecutionPayload {
+			return Snapshot{}, fmt.Errorf("Beacon block for slot %d has no execution payload", beaconBlock.Slot)
+		}
+		return Snapshot{
+			BeaconSlot:    beaconBlock.Slot,
+			ElBlockNumber: beaconBlock.ExecutionBlockNumber,
+		}, nil
+	}
+
+	return Snapshot{}, fmt.Errorf("no Beacon block found in the %d slots up to slot %d", maxMissedSlots+1, slot)
+}
+
+// Get a snapshot at an EL block, along with the Beacon slot that proposed it
+func GetSnapshotAtBlock(ec stader.ExecutionClient, bc beacon.Client, beaconConfig beacon.Eth2Config, blockNumber uint64) (Snapshot, error) {
+	header, err := ec.HeaderByNumber(context.Background(), big.NewInt(0).SetUint64(blockNumber))
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("error getting EL block %d: %w", blockNumber, err)
+	}
+	if header.Time < beaconConfig.GenesisTime {
+		return Snapshot{}, fmt.Errorf("EL block %d is older than the Beacon chain", blockNumber)
+	}
+
+	slot := (header.Time - beaconConfig.GenesisTime) / beaconConfig.SecondsPerSlot
+	beaconBlock, exists, err := bc.GetBeaconBlock(fmt.Sprintf("%d", slot))
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("error getting Beacon block for slot %d: %w", slot, err)
+	}
+	if !exists || beaconBlock.ExecutionBlockNumber != blockNumber {
+		return Snapshot{}, fmt.Errorf("EL block %d was not proposed by the Beacon block of slot %d", blockNumber, slot)
+	}
+
+	return Snapshot{
+		BeaconSlot:    slot,
+		ElBlockNumber: blockNumber,
+	}, nil
+}
+
+func getSnapshotForBlockId(bc beacon.Client, blockId string) (Snapshot, error) {
+	beaconBlock, exists, err := bc.GetBeaconBlock(blockId)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("error getting %s Beacon block: %w", blockId, err)
+	}
+	if !exists {
+		return Snapshot{}, fmt.Errorf("the Beacon node has no %s block", blockId)
+	}
+	if !beaconBlock.HasExecutionPayload {
+		return Snapshot{}, fmt.Errorf("%s Beacon block at slot %d has no execution payload", blockId, beaconBlock.Slot)
+	}
+
+	return Snapshot{
+		BeaconSlot:    beaconBlock.Slot,
+		ElBlockNumber: beaconBlock.ExecutionBlockNumber,
+	}, nil
+}
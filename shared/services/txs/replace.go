@@ -0,0 +1,175 @@
+package txs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+// Config
+const (
+	// Execution clients only accept a transaction replacing a pending one if it raises both fees by at least this much
+	ReplacementFeeBumpPercent = 10
+
+	// Gas used by the 0 ETH transfer that cancels a transaction
+	CancelGasLimit = 21000
+)
+
+// Get the lowest fee cap and tip an execution client accepts to replace a transaction
+func GetMinReplacementFees(tx Transaction) (*big.Int, *big.Int) {
+	return bumpFee(tx.GasFeeCap), bumpFee(tx.GasTipCap)
+}
+
+// Get the fees of a transaction replacing another; the requested fees are used if they're at least the replacement minimum
+func GetReplacementFees(tx Transaction, maxFee *big.Int, maxPriorityFee *big.Int) (*big.Int, *big.Int) {
+	minFeeCap, minTipCap := GetMinReplacementFees(tx)
+	feeCap := minFeeCap
+	if maxFee != nil && maxFee.Cmp(feeCap) > 0 {
+		feeCap = maxFee
+	}
+	tipCap := minTipCap
+	if maxPriorityFee != nil && maxPriorityFee.Cmp(tipCap) > 0 {
+		tipCap = maxPriorityFee
+	}
+	if tipCap.Cmp(feeCap) > 0 {
+		feeCap = tipCap
+	}
+	return feeCap, tipCap
+}
+
+// Send a pending transaction again with higher fees
+func SpeedUp(client stader.ExecutionClient, chainID *big.Int, opts *bind.TransactOpts, tx Transaction) (common.Hash, error) {
+	if tx.To == nil {
+		return common.Hash{}, errors.New("contract deployments can't be sped up")
+	}
+	feeCap, tipCap := GetReplacementFees(tx, opts.GasFeeCap, opts.GasTipCap)
+	return sendReplacement(client, opts, tx, types.NewTx(&types.DynamicFeeTx{
+		ChainID:    chainID,
+		Nonce:      tx.Nonce,
+		GasTipCap:  tipCap,
+		GasFeeCap:  feeCap,
+		Gas:        tx.GasLimit,
+		To:         tx.To,
+		Value:      tx.Value,
+		Data:       tx.Data,
+		AccessList: types.AccessList{},
+	}))
+}
+
+// Cancel a pending transaction by sending a 0 ETH transfer to the node itself with its nonce
+func Cancel(client stader.ExecutionClient, chainID *big.Int, opts *bind.TransactOpts, tx Transaction) (common.Hash, error) {
+	feeCap, tipCap := GetReplacementFees(tx, opts.GasFeeCap, opts.GasTipCap)
+	to := tx.From
+	return sendReplacement(client, opts, tx, types.NewTx(&types.DynamicFeeTx{
+		ChainID:    chainID,
+		Nonce:      tx.Nonce,
+		GasTipCap:  tipCap,
+		GasFeeCap:  feeCap,
+		Gas:        CancelGasLimit,
+		To:         &to,
+		Value:      big.NewInt(0),
+		Data:       []byte{},
+		AccessList: types.AccessList{},
+	}))
+}
+
+// Update the pending transactions of an account that were included in a block or whose nonce was used by another transaction
+func UpdatePendingTransactions(store *Store, client stader.ExecutionClient, from common.Address) error {
+
+	// Get the pending transactions
+	pending, err := store.GetPendingTransactions(from)
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// Get the account's latest nonce
+	nonce, err := client.NonceAt(context.Background(), from, nil)
+	if err != nil {
+		return fmt.Errorf("could not get latest nonce: %w", err)
+	}
+
+	// Check the transactions whose nonce was used; one of the transactions with a nonce was included, or none of them
+	byNonce := map[uint64][]Transaction{}
+	for _, tx := range pending {
+		if tx.Nonce < nonce {
+			byNonce[tx.Nonce] = append(byNonce[tx.Nonce], tx)
+		}
+	}
+	for _, txs := range byNonce {
+		included := false
+		for _, tx := range txs {
+			receipt, err := client.TransactionReceipt(context.Background(), tx.Hash)
+			if errors.Is(err, ethereum.NotFound) {
+				continue
+			} else if err != nil {
+				return fmt.Errorf("could not get transaction %s receipt: %w", tx.Hash.Hex(), err)
+			}
+			if err := store.RecordReceipt(receipt); err != nil {
+				return err
+			}
+			included = true
+			break
+		}
+		if included {
+			continue
+		}
+		for _, tx := range txs {
+			if err := store.RecordDropped(tx.Hash); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+
+}
+
+// Sign & send a transaction replacing a pending one
+func sendReplacement(client stader.ExecutionClient, opts *bind.TransactOpts, tx Transaction, replacement *types.Transaction) (common.Hash, error) {
+
+	// Check the transaction is still pending
+	if tx.Status != TxStatus_Pending {
+		return common.Hash{}, fmt.Errorf("transaction %s is not pending, it is %s", tx.Hash.Hex(), tx.Status)
+	}
+	if tx.From != opts.From {
+		return common.Hash{}, fmt.Errorf("transaction %s was not sent by the node account %s", tx.Hash.Hex(), opts.From.Hex())
+	}
+	nonce, err := client.NonceAt(context.Background(), tx.From, nil)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("could not get latest nonce: %w", err)
+	}
+	if tx.Nonce < nonce {
+		return common.Hash{}, fmt.Errorf("transaction %s nonce %d has already been used", tx.Hash.Hex(), tx.Nonce)
+	}
+
+	// Sign & send the replacement
+	signedTx, err := opts.Signer(opts.From, replacement)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := client.SendTransaction(context.Background(), signedTx); err != nil {
+		return common.Hash{}, err
+	}
+	return signedTx.Hash(), nil
+
+}
+
+// Raise a fee by the replacement minimum, rounding up
+func bumpFee(fee *big.Int) *big.Int {
+	if fee == nil {
+		return big.NewInt(0)
+	}
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+ReplacementFeeBumpPercent))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
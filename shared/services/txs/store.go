@@ -0,0 +1,435 @@
+package txs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Config
+const (
+	FileMode     = 0600
+	storeVersion = 1
+
+	// Settled transactions beyond this many are dropped from the store, oldest first
+	maxSettledTransactions = 1000
+)
+
+// The state of a transaction sent by the node wallet
+type TxStatus string
+
+const (
+	// The transaction was sent but hasn't been included in a block yet
+	TxStatus_Pending TxStatus = "pending"
+
+	// The transaction was included in a block and succeeded
+	TxStatus_Succeeded TxStatus = "succeeded"
+
+	// The transaction was included in a block and reverted
+	TxStatus_Failed TxStatus = "failed"
+
+	// Another transaction sent by the node with the same nonce replaced it
+	TxStatus_Replaced TxStatus = "replaced"
+
+	// Its nonce was used by a transaction the node has no record of
+	TxStatus_Dropped TxStatus = "dropped"
+)
+
+// What a transaction was sent for
+type TxKind string
+
+const (
+	// A transaction sent by a node command
+	TxKind_Original TxKind = "original"
+
+	// The same transaction sent again with higher fees
+	TxKind_SpeedUp TxKind = "speed-up"
+
+	// A 0 ETH transfer to the node itself that takes the nonce of a pending transaction
+	TxKind_Cancel TxKind = "cancel"
+)
+
+// A transaction sent by the node wallet
+type Transaction struct {
+	Hash        common.Hash     `json:"hash"`
+	From        common.Address  `json:"from"`
+	Nonce       uint64          `json:"nonce"`
+	To          *common.Address `json:"to,omitempty"`
+	Value       *big.Int        `json:"value"`
+	Data        hexutil.Bytes   `json:"data"`
+	GasLimit    uint64          `json:"gasLimit"`
+	GasFeeCap   *big.Int        `json:"gasFeeCap"`
+	GasTipCap   *big.Int        `json:"gasTipCap"`
+	Command     string          `json:"command,omitempty"`
+	Kind        TxKind          `json:"kind"`
+	Status      TxStatus        `json:"status"`
+	Replaces    *common.Hash    `json:"replaces,omitempty"`
+	ReplacedBy  *common.Hash    `json:"replacedBy,omitempty"`
+	BlockNumber uint64          `json:"blockNumber,omitempty"`
+	SentAt      time.Time       `json:"sentAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+// The on-disk format of the store
+type storeFile struct {
+	Version      int                     `json:"version"`
+	Transactions map[string]*Transaction `json:"transactions"`
+}
+
+// Persistent record of the transactions sent by the node wallet.
+// The API and the daemon write to the same file, so it is locked and read again around every change.
+type Store struct {
+	path         string
+	transactions map[string]*Transaction
+	lock         sync.Mutex
+}
+
+// Create a new store, loading the existing one from disk if present
+func NewStore(path string) (*Store, error) {
+	s := &Store{
+		path:         path,
+		transactions: map[string]*Transaction{},
+	}
+	unlock, err := s.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Record a transaction sent by the node wallet.
+// Pending transactions from the same account with the same nonce are marked as replaced by it.
+func (s *Store) RecordSent(tx *types.Transaction, command string) error {
+	unlock, err := s.acquire()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err := s.load(); err != nil {
+		return err
+	}
+
+	// Get the sender
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return fmt.Errorf("could not get transaction sender: %w", err)
+	}
+
+	// Create the entry
+	now := time.Now()
+	hash := tx.Hash()
+	entry := &Transaction{
+		Hash:      hash,
+		From:      from,
+		Nonce:     tx.Nonce(),
+		To:        tx.To(),
+		Value:     tx.Value(),
+		Data:      tx.Data(),
+		GasLimit:  tx.Gas(),
+		GasFeeCap: tx.GasFeeCap(),
+		GasTipCap: tx.GasTipCap(),
+		Command:   command,
+		Kind:      TxKind_Original,
+		Status:    TxStatus_Pending,
+		SentAt:    now,
+		UpdatedAt: now,
+	}
+
+	// Mark the transactions it replaces
+	for _, pending := range s.getPendingWithNonce(from, tx.Nonce()) {
+		if pending.Hash == hash {
+			continue
+		}
+		pending.Status = TxStatus_Replaced
+		pending.ReplacedBy = &hash
+		pending.UpdatedAt = now
+		replaced := pending.Hash
+		entry.Replaces = &replaced
+		if isCancellation(entry) {
+			entry.Kind = TxKind_Cancel
+		} else {
+			entry.Kind = TxKind_SpeedUp
+		}
+	}
+	s.transactions[hash.Hex()] = entry
+
+	return s.save()
+}
+
+// Record the receipt of a transaction; other pending transactions with its nonce are marked as replaced by it
+func (s *Store) RecordReceipt(receipt *types.Receipt) error {
+	unlock, err := s.acquire()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err := s.load(); err != nil {
+		return err
+	}
+
+	// Ignore transactions that weren't sent by the node
+	entry, exists := s.transactions[receipt.TxHash.Hex()]
+	if !exists {
+		return nil
+	}
+
+	// Update the transaction
+	now := time.Now()
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		entry.Status = TxStatus_Succeeded
+	} else {
+		entry.Status = TxStatus_Failed
+	}
+	if receipt.BlockNumber != nil {
+		entry.BlockNumber = receipt.BlockNumber.Uint64()
+	}
+	entry.UpdatedAt = now
+
+	// Mark the transactions it replaced
+	for _, pending := range s.getPendingWithNonce(entry.From, entry.Nonce) {
+		hash := entry.Hash
+		pending.Status = TxStatus_Replaced
+		pending.ReplacedBy = &hash
+		pending.UpdatedAt = now
+	}
+
+	return s.save()
+}
+
+// Record that the nonce of a pending transaction was used by a transaction the node has no record of
+func (s *Store) RecordDropped(hash common.Hash) error {
+	unlock, err := s.acquire()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err := s.load(); err != nil {
+		return err
+	}
+
+	entry, exists := s.transactions[hash.Hex()]
+	if !exists || entry.Status != TxStatus_Pending {
+		return nil
+	}
+	entry.Status = TxStatus_Dropped
+	entry.UpdatedAt = time.Now()
+
+	return s.save()
+}
+
+// Get a transaction by hash
+func (s *Store) GetTransaction(hash common.Hash) (Transaction, bool, error) {
+	unlock, err := s.acquire()
+	if err != nil {
+		return Transaction{}, false, err
+	}
+	defer unlock()
+	if err := s.load(); err != nil {
+		return Transaction{}, false, err
+	}
+
+	entry, exists := s.transactions[hash.Hex()]
+	if !exists {
+		return Transaction{}, false, nil
+	}
+	return *entry, true, nil
+}
+
+// Get the pending transactions of an account, sorted by nonce
+func (s *Store) GetPendingTransactions(from common.Address) ([]Transaction, error) {
+	unlock, err := s.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	pending := []Transaction{}
+	for _, entry := range s.transactions {
+		if entry.From == from && entry.Status == TxStatus_Pending {
+			pending = append(pending, *entry)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		if pending[i].Nonce != pending[j].Nonce {
+			return pending[i].Nonce < pending[j].Nonce
+		}
+		return pending[i].SentAt.Before(pending[j].SentAt)
+	})
+	return pending, nil
+}
+
+// Get the transaction that replaced a transaction, following replacements of replacements, and whether it was a cancellation.
+// Returns false if the transaction wasn't replaced.
+func (s *Store) GetReplacement(hash common.Hash) (common.Hash, bool, bool, error) {
+	unlock, err := s.acquire()
+	if err != nil {
+		return common.Hash{}, false, false, err
+	}
+	defer unlock()
+	if err := s.load(); err != nil {
+		return common.Hash{}, false, false, err
+	}
+
+	replaced := false
+	cancelled := false
+	seen := map[common.Hash]bool{}
+	for !seen[hash] {
+		seen[hash] = true
+		entry, exists := s.transactions[hash.Hex()]
+		if !exists || entry.ReplacedBy == nil {
+			break
+		}
+		hash = *entry.ReplacedBy
+		replaced = true
+		if replacement, exists := s.transactions[hash.Hex()]; exists && replacement.Kind == TxKind_Cancel {
+			cancelled = true
+		}
+	}
+	return hash, cancelled, replaced, nil
+}
+
+// Take the store's lock, shared with the other processes using the same file through a lock file next to it.
+// Returns the function that releases it.
+func (s *Store) acquire() (func(), error) {
+	s.lock.Lock()
+
+	// Make sure the data dir exists
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		s.lock.Unlock()
+		return nil, fmt.Errorf("could not create transaction store directory: %w", err)
+	}
+
+	// Lock the lock file
+	lockFile, err := os.OpenFile(s.path+".lock", os.O_CREATE|os.O_RDWR, FileMode)
+	if err != nil {
+		s.lock.Unlock()
+		return nil, fmt.Errorf("could not open transaction store lock file: %w", err)
+	}
+	for {
+		err = syscall.Flock(int(lockFile.Fd()), syscall.LOCK_EX)
+		if err != syscall.EINTR {
+			break
+		}
+	}
+	if err != nil {
+		lockFile.Close()
+		s.lock.Unlock()
+		return nil, fmt.Errorf("could not lock transaction store: %w", err)
+	}
+
+	return func() {
+		_ = syscall.Flock(int(lockFile.Fd()), syscall.LOCK_UN)
+		lockFile.Close()
+		s.lock.Unlock()
+	}, nil
+}
+
+// Get the pending transactions of an account with a nonce. The lock must be held by the caller.
+func (s *Store) getPendingWithNonce(from common.Address, nonce uint64) []*Transaction {
+	pending := []*Transaction{}
+	for _, entry := range s.transactions {
+		if entry.From == from && entry.Nonce == nonce && entry.Status == TxStatus_Pending {
+			pending = append(pending, entry)
+		}
+	}
+	return pending
+}
+
+// Write the store to disk, dropping the oldest settled transactions. The lock must be held by the caller.
+func (s *Store) save() error {
+
+	// Drop the oldest settled transactions
+	settled := []*Transaction{}
+	for _, entry := range s.transactions {
+		if entry.Status != TxStatus_Pending {
+			settled = append(settled, entry)
+		}
+	}
+	if len(settled) > maxSettledTransactions {
+		sort.Slice(settled, func(i, j int) bool {
+			return settled[i].UpdatedAt.Before(settled[j].UpdatedAt)
+		})
+		for _, entry := range settled[:len(settled)-maxSettledTransactions] {
+			delete(s.transactions, entry.Hash.Hex())
+		}
+	}
+
+	bytes, err := json.MarshalIndent(storeFile{
+		Version:      storeVersion,
+		Transactions: s.transactions,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not serialize transaction store: %w", err)
+	}
+
+	// Write to a temporary file first so an interrupted write never corrupts the store
+	tempFile, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("could not create temporary transaction store: %w", err)
+	}
+	tempPath := tempFile.Name()
+	_, err = tempFile.Write(bytes)
+	if err == nil {
+		err = tempFile.Chmod(FileMode)
+	}
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("could not write transaction store to disk: %w", err)
+	}
+	if err := os.Rename(tempPath, s.path); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("could not replace transaction store: %w", err)
+	}
+
+	return nil
+}
+
+// Read the store from disk. The lock must be held by the caller.
+func (s *Store) load() error {
+	bytes, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("could not read transaction store from disk: %w", err)
+	}
+
+	var file storeFile
+	if err := json.Unmarshal(bytes, &file); err != nil {
+		return fmt.Errorf("could not parse transaction store %s: %w", s.path, err)
+	}
+	if file.Version != storeVersion {
+		return fmt.Errorf("unsupported transaction store version %d", file.Version)
+	}
+	s.transactions = map[string]*Transaction{}
+	if file.Transactions != nil {
+		s.transactions = file.Transactions
+	}
+
+	return nil
+}
+
+// Check if a transaction is a 0 ETH transfer to its sender with no data, which is how pending transactions are cancelled
+func isCancellation(tx *Transaction) bool {
+	return tx.To != nil && *tx.To == tx.From && tx.Value.Sign() == 0 && len(tx.Data) == 0
+}
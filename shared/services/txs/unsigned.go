@@ -0,0 +1,103 @@
+package txs
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Config
+const UnsignedTransactionVersion = 1
+
+// An EIP-1559 transaction for the node account, built by the node to be signed on an offline machine
+type UnsignedTransaction struct {
+	Version              int             `json:"version"`
+	Command              string          `json:"command,omitempty"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+	From                 common.Address  `json:"from"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	To                   *common.Address `json:"to,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Data                 hexutil.Bytes   `json:"data"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+}
+
+// A transaction signed on an offline machine, ready to be broadcast by the node
+type SignedTransaction struct {
+	Hash           common.Hash    `json:"hash"`
+	From           common.Address `json:"from"`
+	Nonce          hexutil.Uint64 `json:"nonce"`
+	RawTransaction hexutil.Bytes  `json:"rawTransaction"`
+}
+
+// Create an unsigned transaction from a transaction built but not signed by the node
+func NewUnsignedTransaction(tx *types.Transaction, from common.Address, command string) (*UnsignedTransaction, error) {
+	if tx.Type() != types.DynamicFeeTxType {
+		return nil, fmt.Errorf("unsupported transaction type %d, only EIP-1559 transactions can be signed offline", tx.Type())
+	}
+	return &UnsignedTransaction{
+		Version:              UnsignedTransactionVersion,
+		Command:              command,
+		ChainID:              (*hexutil.Big)(tx.ChainId()),
+		From:                 from,
+		Nonce:                hexutil.Uint64(tx.Nonce()),
+		To:                   tx.To(),
+		Value:                (*hexutil.Big)(tx.Value()),
+		Data:                 tx.Data(),
+		Gas:                  hexutil.Uint64(tx.Gas()),
+		MaxFeePerGas:         (*hexutil.Big)(tx.GasFeeCap()),
+		MaxPriorityFeePerGas: (*hexutil.Big)(tx.GasTipCap()),
+	}, nil
+}
+
+// Build the transaction to sign
+func (u *UnsignedTransaction) ToTransaction() (*types.Transaction, error) {
+	if u.Version != UnsignedTransactionVersion {
+		return nil, fmt.Errorf("unsupported unsigned transaction version %d", u.Version)
+	}
+	if u.ChainID == nil || u.MaxFeePerGas == nil || u.MaxPriorityFeePerGas == nil {
+		return nil, errors.New("the unsigned transaction is missing its chain ID or fees")
+	}
+	if u.Gas == 0 {
+		return nil, errors.New("the unsigned transaction has no gas limit")
+	}
+	value := big.NewInt(0)
+	if u.Value != nil {
+		value = u.Value.ToInt()
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:    u.ChainID.ToInt(),
+		Nonce:      uint64(u.Nonce),
+		GasTipCap:  u.MaxPriorityFeePerGas.ToInt(),
+		GasFeeCap:  u.MaxFeePerGas.ToInt(),
+		Gas:        uint64(u.Gas),
+		To:         u.To,
+		Value:      value,
+		Data:       u.Data,
+		AccessList: types.AccessList{},
+	}), nil
+}
+
+// Create a signed transaction from the raw bytes of a transaction signed offline, checking its signature
+func NewSignedTransaction(rawTx []byte) (*SignedTransaction, *types.Transaction, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(rawTx); err != nil {
+		return nil, nil, fmt.Errorf("could not decode signed transaction: %w", err)
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get signed transaction sender: %w", err)
+	}
+	return &SignedTransaction{
+		Hash:           tx.Hash(),
+		From:           from,
+		Nonce:          hexutil.Uint64(tx.Nonce()),
+		RawTransaction: rawTx,
+	}, tx, nil
+}
@@ -0,0 +1,46 @@
+package external
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Returned when the node is asked to sign for a node account whose key is kept on an offline machine
+var ErrOfflineNodeSigner = errors.New("The node account key is kept offline; use --unsigned-out to write the transaction to a file, sign it with `stader-cli wallet sign-tx` on the offline machine and send it with `stader-cli node broadcast`")
+
+// Node account signer for keys kept on an offline machine.
+// It only knows the node account address, so transactions are built by the node and signed elsewhere.
+type OfflineSigner struct {
+	address common.Address
+}
+
+// Create a signer for the node account kept offline
+func NewOfflineSigner(address common.Address) *OfflineSigner {
+	return &OfflineSigner{
+		address: address,
+	}
+}
+
+// Get the node account address
+func (s *OfflineSigner) GetAddress() common.Address {
+	return s.address
+}
+
+// Get where the node account key is held
+func (s *OfflineSigner) GetUrl() accounts.URL {
+	return accounts.URL{Scheme: "offline", Path: s.address.Hex()}
+}
+
+// Offline keys can't sign transactions on the node
+func (s *OfflineSigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return nil, ErrOfflineNodeSigner
+}
+
+// Offline keys can't sign messages on the node
+func (s *OfflineSigner) SignText(message []byte) ([]byte, error) {
+	return nil, ErrOfflineNodeSigner
+}
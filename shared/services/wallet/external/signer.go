@@ -0,0 +1,218 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/stader-labs/stader-node/shared/types/config"
+)
+
+// Config
+const (
+	requestTimeout = 2 * time.Minute
+
+	clefSignTransactionMethod    = "account_signTransaction"
+	clefSignDataMethod           = "account_signData"
+	jsonRpcSignTransactionMethod = "eth_signTransaction"
+	jsonRpcSignMethod            = "eth_sign"
+
+	textPlainContentType = "text/plain"
+)
+
+// Transaction sent to the signer
+type txArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to,omitempty"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                hexutil.Big       `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big      `json:"chainId,omitempty"`
+}
+
+// Signed transaction returned by the signer
+type signTransactionResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// Node account signer backed by a Clef or JSON-RPC signer process.
+// Clef requires every request to be approved by its rules or its operator, so requests can take a while.
+type Signer struct {
+	signerType config.NodeSignerType
+	url        string
+	address    common.Address
+}
+
+// Create a signer for the node account held by the signer at the given HTTP URL or IPC path
+func NewSigner(signerType config.NodeSignerType, url string, address common.Address) (*Signer, error) {
+	switch signerType {
+	case config.NodeSignerType_Clef, config.NodeSignerType_JsonRpc:
+	default:
+		return nil, fmt.Errorf("unknown external node signer type [%v]", signerType)
+	}
+	if url == "" {
+		return nil, fmt.Errorf("the external node signer URL is not set")
+	}
+	return &Signer{
+		signerType: signerType,
+		url:        url,
+		address:    address,
+	}, nil
+}
+
+// Get the node account address
+func (s *Signer) GetAddress() common.Address {
+	return s.address
+}
+
+// Get where the node account key is held
+func (s *Signer) GetUrl() accounts.URL {
+	return accounts.URL{
+		Scheme: string(s.signerType),
+		Path:   s.url,
+	}
+}
+
+// Sign a transaction for the given chain, checking the signer signed it unchanged with the node account
+func (s *Signer) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+
+	// Build the request
+	args := txArgs{
+		From:    s.address,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", tx.Type())
+	}
+
+	// Sign it
+	method := jsonRpcSignTransactionMethod
+	if s.signerType == config.NodeSignerType_Clef {
+		method = clefSignTransactionMethod
+	}
+	var result json.RawMessage
+	if err := s.call(&result, method, args); err != nil {
+		return nil, fmt.Errorf("could not sign transaction with the external signer: %w", err)
+	}
+	raw, err := decodeSignedTransaction(result)
+	if err != nil {
+		return nil, err
+	}
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("could not decode the transaction signed by the external signer: %w", err)
+	}
+
+	// Make sure the signer didn't change the transaction or sign it with another account
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signedTx) != signer.Hash(tx) {
+		return nil, fmt.Errorf("the external signer signed a different transaction than the one requested")
+	}
+	sender, err := types.Sender(signer, signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("could not recover the sender of the transaction signed by the external signer: %w", err)
+	}
+	if sender != s.address {
+		return nil, fmt.Errorf("the external signer signed the transaction with %s instead of the node account %s", sender.Hex(), s.address.Hex())
+	}
+
+	return signedTx, nil
+
+}
+
+// Sign a text message (EIP-191), checking the signer signed it with the node account
+func (s *Signer) SignText(message []byte) ([]byte, error) {
+
+	var signature hexutil.Bytes
+	var err error
+	if s.signerType == config.NodeSignerType_Clef {
+		err = s.call(&signature, clefSignDataMethod, textPlainContentType, common.NewMixedcaseAddress(s.address), hexutil.Bytes(message))
+	} else {
+		err = s.call(&signature, jsonRpcSignMethod, s.address, hexutil.Bytes(message))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not sign message with the external signer: %w", err)
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("the external signer returned a signature of %d bytes instead of %d", len(signature), crypto.SignatureLength)
+	}
+
+	// Signers return a 'v' of either 0/1 or 27/28, the node always uses 27/28
+	if signature[crypto.RecoveryIDOffset] < 27 {
+		signature[crypto.RecoveryIDOffset] += 27
+	}
+
+	// Make sure the message was signed by the node account
+	recoverable := make([]byte, crypto.SignatureLength)
+	copy(recoverable, signature)
+	recoverable[crypto.RecoveryIDOffset] -= 27
+	publicKey, err := crypto.SigToPub(accounts.TextHash(message), recoverable)
+	if err != nil {
+		return nil, fmt.Errorf("could not recover the signer of the message signed by the external signer: %w", err)
+	}
+	if signer := crypto.PubkeyToAddress(*publicKey); signer != s.address {
+		return nil, fmt.Errorf("the external signer signed the message with %s instead of the node account %s", signer.Hex(), s.address.Hex())
+	}
+
+	return signature, nil
+
+}
+
+// Make a JSON-RPC call to the signer.
+// A new connection is used for each call so a restarted signer is picked up again.
+func (s *Signer) call(result interface{}, method string, args ...interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	client, err := rpc.DialContext(ctx, s.url)
+	if err != nil {
+		return fmt.Errorf("could not connect to the external signer at %s: %w", s.url, err)
+	}
+	defer client.Close()
+
+	return client.CallContext(ctx, result, method, args...)
+}
+
+// Get the raw signed transaction from a signer response, either a {raw, tx} object or the raw transaction itself
+func decodeSignedTransaction(result json.RawMessage) ([]byte, error) {
+	var signed signTransactionResult
+	if err := json.Unmarshal(result, &signed); err == nil && len(signed.Raw) > 0 {
+		return signed.Raw, nil
+	}
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		return nil, fmt.Errorf("could not decode the external signer response: %w", err)
+	}
+	return raw, nil
+}
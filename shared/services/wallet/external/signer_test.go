@@ -0,0 +1,317 @@
+package external
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/stader-labs/stader-node/shared/types/config"
+)
+
+var testChainID = big.NewInt(5)
+
+// A stand-in Clef or JSON-RPC signer holding one key
+type testSigner struct {
+	t   *testing.T
+	key *ecdsa.PrivateKey
+
+	// Hooks to misbehave: change the transaction before signing, sign with another key, or fail the call
+	mutate  func(args *txArgs)
+	signKey *ecdsa.PrivateKey
+	rpcErr  string
+	rawOnly bool
+
+	methods []string
+}
+
+type rpcRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type rpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+func newTestSigner(t *testing.T) *testSigner {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testSigner{t: t, key: key}
+}
+
+func (s *testSigner) address() common.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+func (s *testSigner) start() *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(s.serve))
+	s.t.Cleanup(server.Close)
+	return server
+}
+
+func (s *testSigner) serve(w http.ResponseWriter, r *http.Request) {
+	var request rpcRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.methods = append(s.methods, request.Method)
+
+	response := rpcResponse{Version: "2.0", ID: request.ID}
+	if s.rpcErr != "" {
+		response.Error = &rpcError{Code: -32000, Message: s.rpcErr}
+	} else if result, err := s.handle(request); err != nil {
+		response.Error = &rpcError{Code: -32602, Message: err.Error()}
+	} else {
+		response.Result = result
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (s *testSigner) handle(request rpcRequest) (interface{}, error) {
+	signKey := s.key
+	if s.signKey != nil {
+		signKey = s.signKey
+	}
+
+	switch request.Method {
+	case jsonRpcSignTransactionMethod, clefSignTransactionMethod:
+		var args txArgs
+		if err := json.Unmarshal(request.Params[0], &args); err != nil {
+			return nil, err
+		}
+		if s.mutate != nil {
+			s.mutate(&args)
+		}
+		tx, err := types.SignTx(buildTx(args), types.LatestSignerForChainID(args.ChainID.ToInt()), signKey)
+		if err != nil {
+			return nil, err
+		}
+		raw, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		if s.rawOnly {
+			return hexutil.Bytes(raw), nil
+		}
+		return map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": tx}, nil
+
+	case jsonRpcSignMethod, clefSignDataMethod:
+		var message hexutil.Bytes
+		if err := json.Unmarshal(request.Params[len(request.Params)-1], &message); err != nil {
+			return nil, err
+		}
+		signature, err := crypto.Sign(accounts.TextHash(message), signKey)
+		if err != nil {
+			return nil, err
+		}
+		return hexutil.Bytes(signature), nil
+	}
+
+	s.t.Errorf("unexpected signer method %s", request.Method)
+	return nil, nil
+}
+
+// Rebuild the transaction a signer was asked to sign
+func buildTx(args txArgs) *types.Transaction {
+	if args.MaxFeePerGas != nil {
+		var accessList types.AccessList
+		if args.AccessList != nil {
+			accessList = *args.AccessList
+		}
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    args.ChainID.ToInt(),
+			Nonce:      uint64(args.Nonce),
+			GasTipCap:  args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap:  args.MaxFeePerGas.ToInt(),
+			Gas:        uint64(args.Gas),
+			To:         args.To,
+			Value:      args.Value.ToInt(),
+			Data:       args.Data,
+			AccessList: accessList,
+		})
+	}
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    uint64(args.Nonce),
+		GasPrice: args.GasPrice.ToInt(),
+		Gas:      uint64(args.Gas),
+		To:       args.To,
+		Value:    args.Value.ToInt(),
+		Data:     args.Data,
+	})
+}
+
+func newDynamicFeeTx() *types.Transaction {
+	to := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     7,
+		GasTipCap: big.NewInt(2e9),
+		GasFeeCap: big.NewInt(30e9),
+		Gas:       100000,
+		To:        &to,
+		Value:     big.NewInt(1e18),
+		Data:      []byte{0xde, 0xad, 0xbe, 0xef},
+	})
+}
+
+func TestSignTx(t *testing.T) {
+	to := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	legacyTx := types.NewTx(&types.LegacyTx{
+		Nonce:    3,
+		GasPrice: big.NewInt(20e9),
+		Gas:      21000,
+		To:       &to,
+		Value:    big.NewInt(5),
+	})
+
+	tests := []struct {
+		name       string
+		signerType config.NodeSignerType
+		method     string
+		rawOnly    bool
+		tx         *types.Transaction
+	}{
+		{"json-rpc", config.NodeSignerType_JsonRpc, jsonRpcSignTransactionMethod, false, newDynamicFeeTx()},
+		{"json-rpc raw response", config.NodeSignerType_JsonRpc, jsonRpcSignTransactionMethod, true, newDynamicFeeTx()},
+		{"json-rpc legacy", config.NodeSignerType_JsonRpc, jsonRpcSignTransactionMethod, false, legacyTx},
+		{"clef", config.NodeSignerType_Clef, clefSignTransactionMethod, false, newDynamicFeeTx()},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stand := newTestSigner(t)
+			stand.rawOnly = test.rawOnly
+			server := stand.start()
+
+			signer, err := NewSigner(test.signerType, server.URL, stand.address())
+			if err != nil {
+				t.Fatal(err)
+			}
+			signedTx, err := signer.SignTx(test.tx, testChainID)
+			if err != nil {
+				t.Fatalf("error signing transaction: %s", err)
+			}
+
+			if len(stand.methods) != 1 || stand.methods[0] != test.method {
+				t.Errorf("signer was called with %v, expected %s", stand.methods, test.method)
+			}
+			ethSigner := types.LatestSignerForChainID(testChainID)
+			if ethSigner.Hash(signedTx) != ethSigner.Hash(test.tx) {
+				t.Error("signed transaction differs from the requested one")
+			}
+			sender, err := types.Sender(ethSigner, signedTx)
+			if err != nil || sender != stand.address() {
+				t.Errorf("signed transaction sender is %s (%v), expected %s", sender.Hex(), err, stand.address().Hex())
+			}
+		})
+	}
+}
+
+func TestSignTxRejectsMismatches(t *testing.T) {
+
+	// The signer changes the transaction
+	stand := newTestSigner(t)
+	stand.mutate = func(args *txArgs) {
+		args.Nonce++
+	}
+	server := stand.start()
+	signer, _ := NewSigner(config.NodeSignerType_JsonRpc, server.URL, stand.address())
+	if _, err := signer.SignTx(newDynamicFeeTx(), testChainID); err == nil || !strings.Contains(err.Error(), "different transaction") {
+		t.Errorf("expected a tx hash mismatch error, got %v", err)
+	}
+
+	// The signer signs with another account
+	stand = newTestSigner(t)
+	otherKey, _ := crypto.GenerateKey()
+	stand.signKey = otherKey
+	server = stand.start()
+	signer, _ = NewSigner(config.NodeSignerType_Clef, server.URL, stand.address())
+	if _, err := signer.SignTx(newDynamicFeeTx(), testChainID); err == nil || !strings.Contains(err.Error(), "instead of the node account") {
+		t.Errorf("expected a sender mismatch error, got %v", err)
+	}
+}
+
+func TestSignErrors(t *testing.T) {
+
+	// The signer rejects the request
+	stand := newTestSigner(t)
+	stand.rpcErr = "Request denied"
+	server := stand.start()
+	signer, _ := NewSigner(config.NodeSignerType_Clef, server.URL, stand.address())
+	if _, err := signer.SignTx(newDynamicFeeTx(), testChainID); err == nil || !strings.Contains(err.Error(), "Request denied") {
+		t.Errorf("expected the signer's error, got %v", err)
+	}
+	if _, err := signer.SignText([]byte("hello")); err == nil || !strings.Contains(err.Error(), "Request denied") {
+		t.Errorf("expected the signer's error, got %v", err)
+	}
+
+	// The signer fails at the HTTP level
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "signer unavailable", http.StatusServiceUnavailable)
+	}))
+	signer, _ = NewSigner(config.NodeSignerType_JsonRpc, server.URL, stand.address())
+	if _, err := signer.SignTx(newDynamicFeeTx(), testChainID); err == nil || !strings.Contains(err.Error(), "could not sign transaction") {
+		t.Errorf("expected an HTTP error, got %v", err)
+	}
+
+	// The signer is not reachable
+	server.Close()
+	if _, err := signer.SignText([]byte("hello")); err == nil {
+		t.Error("expected an error for an unreachable signer")
+	}
+
+	// Invalid configuration
+	if _, err := NewSigner(config.NodeSignerType_Clef, "", stand.address()); err == nil {
+		t.Error("expected an error for a blank URL")
+	}
+	if _, err := NewSigner(config.NodeSignerType("unknown"), server.URL, stand.address()); err == nil {
+		t.Error("expected an error for an unknown signer type")
+	}
+}
+
+func TestSignText(t *testing.T) {
+	message := []byte("stader node")
+	for _, signerType := range []config.NodeSignerType{config.NodeSignerType_JsonRpc, config.NodeSignerType_Clef} {
+		stand := newTestSigner(t)
+		server := stand.start()
+		signer, _ := NewSigner(signerType, server.URL, stand.address())
+
+		// The stand-in returns a 'v' of 0/1, which is normalized to 27/28
+		signature, err := signer.SignText(message)
+		if err != nil {
+			t.Fatalf("%s: error signing message: %s", signerType, err)
+		}
+		if v := signature[crypto.RecoveryIDOffset]; v != 27 && v != 28 {
+			t.Errorf("%s: signature has a 'v' of %d", signerType, v)
+		}
+
+		// Messages signed by another account are rejected
+		otherKey, _ := crypto.GenerateKey()
+		stand.signKey = otherKey
+		if _, err := signer.SignText(message); err == nil || !strings.Contains(err.Error(), "instead of the node account") {
+			t.Errorf("%s: expected a signer mismatch error, got %v", signerType, err)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	stadertypes "github.com/stader-labs/stader-node/stader-lib/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// Config
+const (
+	ImportedKeysFilename = "imported-validator-keys"
+)
+
+// A validator key imported from an EIP-2335 keystore.
+// Imported keys are not derived from the wallet mnemonic, so they can't be recovered with it.
+// A held key is kept out of the validator keystores until its slashing protection history is in the validator client.
+type ImportedValidatorKey struct {
+	PublicKey      stadertypes.ValidatorPubkey `json:"pubkey"`
+	DerivationPath string                      `json:"path"`
+	ImportedAt     time.Time                   `json:"importedAt"`
+	Held           bool                        `json:"held,omitempty"`
+}
+
+// Encrypted store of imported validator keys, kept next to the wallet
+type importedKeyStore struct {
+	Version uint          `json:"version"`
+	Keys    []importedKey `json:"keys"`
+}
+
+// Imported validator key, encrypted with the wallet password
+type importedKey struct {
+	ImportedValidatorKey
+	Crypto map[string]interface{} `json:"crypto"`
+	UUID   uuid.UUID              `json:"uuid"`
+}
+
+// EIP-2335 keystore as written by the staking deposit CLI and other node software
+type eip2335Keystore struct {
+	Crypto  map[string]interface{} `json:"crypto"`
+	Version uint                   `json:"version"`
+	Pubkey  string                 `json:"pubkey"`
+	Path    string                 `json:"path"`
+}
+
+// Import a validator key from an EIP-2335 keystore and its password.
+// The key is kept in the wallet, encrypted with the wallet password, and held out of the validator keystores until it is released.
+func (w *Wallet) ImportValidatorKey(keystoreJson []byte, password string) (ImportedValidatorKey, error) {
+
+	// Check wallet is initialized
+	if !w.IsInitialized() {
+		return ImportedValidatorKey{}, errors.New("Wallet is not initialized")
+	}
+
+	// Decode & decrypt keystore
+	var ks eip2335Keystore
+	if err := json.Unmarshal(keystoreJson, &ks); err != nil {
+		return ImportedValidatorKey{}, fmt.Errorf("Could not decode keystore: %w", err)
+	}
+	if ks.Version != 4 || ks.Crypto == nil {
+		return ImportedValidatorKey{}, fmt.Errorf("Unsupported keystore version %d, only EIP-2335 (version 4) keystores can be imported", ks.Version)
+	}
+	secret, err := eth2ks.New().Decrypt(ks.Crypto, password)
+	if err != nil {
+		return ImportedValidatorKey{}, fmt.Errorf("Could not decrypt keystore, check the keystore password: %w", err)
+	}
+
+	// Get private key
+	if err := initializeBLS(); err != nil {
+		return ImportedValidatorKey{}, fmt.Errorf("Could not initialize BLS library: %w", err)
+	}
+	key, err := eth2types.BLSPrivateKeyFromBytes(secret)
+	if err != nil {
+		return ImportedValidatorKey{}, fmt.Errorf("Could not get validator private key from keystore: %w", err)
+	}
+	pubkey := stadertypes.BytesToValidatorPubkey(key.PublicKey().Marshal())
+
+	// Check the key matches the keystore pubkey, if the keystore has one
+	if ks.Pubkey != "" {
+		keystorePubkey, err := hex.DecodeString(strings.TrimPrefix(ks.Pubkey, "0x"))
+		if err != nil {
+			return ImportedValidatorKey{}, fmt.Errorf("Invalid keystore pubkey '%s': %w", ks.Pubkey, err)
+		}
+		if !bytes.Equal(keystorePubkey, pubkey.Bytes()) {
+			return ImportedValidatorKey{}, fmt.Errorf("Keystore pubkey %s does not match its key %s", ks.Pubkey, pubkey.Hex())
+		}
+	}
+
+	// Check the key isn't already held by the wallet
+	if _, ok := w.importedKeys[pubkey.Hex()]; ok {
+		return ImportedValidatorKey{}, fmt.Errorf("Validator %s key has already been imported", pubkey.Hex())
+	}
+	for index := uint(0); index < w.ws.NextAccount; index++ {
+		if derivedKey, _, err := w.getValidatorPrivateKey(index); err != nil {
+			return ImportedValidatorKey{}, err
+		} else if bytes.Equal(pubkey.Bytes(), derivedKey.PublicKey().Marshal()) {
+			return ImportedValidatorKey{}, fmt.Errorf("Validator %s key is derived from the wallet mnemonic and does not need to be imported", pubkey.Hex())
+		}
+	}
+
+	// Encrypt key with the wallet password
+	walletPassword, err := w.pm.GetPassword()
+	if err != nil {
+		return ImportedValidatorKey{}, fmt.Errorf("Could not get wallet password: %w", err)
+	}
+	encryptedKey, err := w.encryptor.Encrypt(key.Marshal(), walletPassword)
+	if err != nil {
+		return ImportedValidatorKey{}, fmt.Errorf("Could not encrypt validator key: %w", err)
+	}
+
+	// Record & save the imported key
+	imported := importedKey{
+		ImportedValidatorKey: ImportedValidatorKey{
+			PublicKey:      pubkey,
+			DerivationPath: ks.Path,
+			ImportedAt:     time.Now().UTC(),
+			Held:           true,
+		},
+		Crypto: encryptedKey,
+		UUID:   uuid.New(),
+	}
+	w.importedKeys[pubkey.Hex()] = imported
+	if err := w.saveImportedKeys(); err != nil {
+		delete(w.importedKeys, pubkey.Hex())
+		return ImportedValidatorKey{}, err
+	}
+	w.importedKeyCache[pubkey.Hex()] = key
+
+	// Return
+	return imported.ImportedValidatorKey, nil
+
+}
+
+// Store a held imported validator key in every validator keystore, so the validator client loads it
+func (w *Wallet) ReleaseImportedValidatorKey(pubkey stadertypes.ValidatorPubkey) error {
+
+	// Check the key is held
+	imported, ok := w.importedKeys[pubkey.Hex()]
+	if !ok {
+		return fmt.Errorf("Validator %s key was not imported", pubkey.Hex())
+	}
+	if !imported.Held {
+		return nil
+	}
+
+	// Update keystores
+	key, err := w.getImportedValidatorKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if err := w.StoreValidatorKey(key, imported.DerivationPath); err != nil {
+		return err
+	}
+
+	// Record & save the release
+	imported.Held = false
+	w.importedKeys[pubkey.Hex()] = imported
+	if err := w.saveImportedKeys(); err != nil {
+		imported.Held = true
+		w.importedKeys[pubkey.Hex()] = imported
+		return err
+	}
+
+	// Return
+	return nil
+
+}
+
+// Get the validator keys imported into the wallet, which can't be recovered from the mnemonic
+func (w *Wallet) GetImportedValidatorKeys() ([]ImportedValidatorKey, error) {
+
+	// Check wallet is initialized
+	if !w.IsInitialized() {
+		return nil, errors.New("Wallet is not initialized")
+	}
+
+	keys := make([]ImportedValidatorKey, 0, len(w.importedKeys))
+	for _, imported := range w.importedKeys {
+		keys = append(keys, imported.ImportedValidatorKey)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ImportedAt.Before(keys[j].ImportedAt)
+	})
+	return keys, nil
+
+}
+
+// Check if a validator key was imported into the wallet
+func (w *Wallet) IsImportedValidatorKey(pubkey stadertypes.ValidatorPubkey) bool {
+	_, ok := w.importedKeys[pubkey.Hex()]
+	return ok
+}
+
+// Get an imported validator key by public key; returns nil if it wasn't imported
+func (w *Wallet) getImportedValidatorKey(pubkey stadertypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+
+	// Get pubkey hex string
+	pubkeyHex := pubkey.Hex()
+
+	// Check the key was imported
+	imported, ok := w.importedKeys[pubkeyHex]
+	if !ok {
+		return nil, nil
+	}
+
+	// Check for cached key; decrypting is slow, so keys are only decrypted when they're used
+	if key, ok := w.importedKeyCache[pubkeyHex]; ok {
+		return key, nil
+	}
+
+	// Decrypt key
+	password, err := w.pm.GetPassword()
+	if err != nil {
+		return nil, fmt.Errorf("Could not get wallet password: %w", err)
+	}
+	secret, err := w.encryptor.Decrypt(imported.Crypto, password)
+	if err != nil {
+		return nil, fmt.Errorf("Could not decrypt imported validator %s key: %w", pubkeyHex, err)
+	}
+	if err := initializeBLS(); err != nil {
+		return nil, fmt.Errorf("Could not initialize BLS library: %w", err)
+	}
+	key, err := eth2types.BLSPrivateKeyFromBytes(secret)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get imported validator %s private key: %w", pubkeyHex, err)
+	}
+	if !bytes.Equal(pubkey.Bytes(), key.PublicKey().Marshal()) {
+		return nil, fmt.Errorf("Imported validator %s key does not match its pubkey", pubkeyHex)
+	}
+
+	// Cache key
+	w.importedKeyCache[pubkeyHex] = key
+
+	// Return
+	return key, nil
+
+}
+
+// Get the path of the imported validator key store
+func (w *Wallet) getImportedKeysPath() string {
+	return filepath.Join(filepath.Dir(w.walletPath), ImportedKeysFilename)
+}
+
+// Load the imported validator key store from disk; keys are decrypted when they're used
+func (w *Wallet) loadImportedKeys() error {
+	store, err := readImportedKeyStore(w.getImportedKeysPath())
+	if err != nil {
+		return err
+	}
+	w.importedKeys = map[string]importedKey{}
+	for _, imported := range store.Keys {
+		w.importedKeys[imported.PublicKey.Hex()] = imported
+	}
+	return nil
+}
+
+// Get the validator keys imported into the wallet in the given folder without opening the wallet.
+// The store only encrypts the keys themselves, so this doesn't need the wallet password.
+func LoadImportedValidatorKeys(walletDir string) ([]ImportedValidatorKey, error) {
+	store, err := readImportedKeyStore(filepath.Join(walletDir, ImportedKeysFilename))
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]ImportedValidatorKey, len(store.Keys))
+	for i, imported := range store.Keys {
+		keys[i] = imported.ImportedValidatorKey
+	}
+	return keys, nil
+}
+
+// Read an imported validator key store; it is empty if it isn't found
+func readImportedKeyStore(path string) (importedKeyStore, error) {
+
+	// Read the store from disk
+	storeBytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return importedKeyStore{}, nil
+	} else if err != nil {
+		return importedKeyStore{}, fmt.Errorf("Could not read imported validator keys: %w", err)
+	}
+
+	// Decode the store
+	var store importedKeyStore
+	if err := json.Unmarshal(storeBytes, &store); err != nil {
+		return importedKeyStore{}, fmt.Errorf("Could not decode imported validator keys: %w", err)
+	}
+	return store, nil
+
+}
+
+// Save the imported validator key store to disk
+func (w *Wallet) saveImportedKeys() error {
+
+	// Encode the store
+	storeBytes, err := w.encodeImportedKeys(w.importedKeys)
+	if err != nil {
+		return err
+	}
+
+	// Write the store to disk
+	if err := ioutil.WriteFile(w.getImportedKeysPath(), storeBytes, FileMode); err != nil {
+		return fmt.Errorf("Could not write imported validator keys to disk: %w", err)
+	}
+
+	// Return
+	return nil
+
+}
+
+// Encode an imported validator key store holding the given keys
+func (w *Wallet) encodeImportedKeys(keys map[string]importedKey) ([]byte, error) {
+
+	// Build the store
+	store := importedKeyStore{
+		Version: w.encryptor.Version(),
+		Keys:    make([]importedKey, 0, len(keys)),
+	}
+	for _, imported := range keys {
+		store.Keys = append(store.Keys, imported)
+	}
+	sort.Slice(store.Keys, func(i, j int) bool {
+		return store.Keys[i].ImportedAt.Before(store.Keys[j].ImportedAt)
+	})
+
+	// Encode the store
+	storeBytes, err := json.Marshal(store)
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode imported validator keys: %w", err)
+	}
+	return storeBytes, nil
+
+}
+
+// Delete the imported validator key store from disk
+func (w *Wallet) deleteImportedKeys() error {
+	err := os.Remove(w.getImportedKeysPath())
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error deleting imported validator keys: %w", err)
+	}
+	w.importedKeys = map[string]importedKey{}
+	w.importedKeyCache = map[string]*eth2types.BLSPrivateKey{}
+	return nil
+}
@@ -0,0 +1,116 @@
+package keystore
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// Config
+const (
+	batchTempFileSuffix = ".new"
+)
+
+// A set of file writes applied together.
+// Every new file is written next to its target first, then moved over it; if any move fails, the files already replaced are restored.
+type FileBatch struct {
+	files []batchFile
+}
+type batchFile struct {
+	path     string
+	data     []byte
+	mode     os.FileMode
+	original []byte
+	existed  bool
+}
+
+// Add a file write to the batch
+func (b *FileBatch) Add(path string, data []byte, mode os.FileMode) {
+	b.files = append(b.files, batchFile{
+		path: path,
+		data: data,
+		mode: mode,
+	})
+}
+
+// Get the number of file writes in the batch
+func (b *FileBatch) Len() int {
+	return len(b.files)
+}
+
+// Apply every file write in the batch, or none of them
+func (b *FileBatch) Commit() error {
+
+	// Keep the current file contents to restore them
+	for i := range b.files {
+		file := &b.files[i]
+		original, err := ioutil.ReadFile(file.path)
+		if err == nil {
+			file.original = original
+			file.existed = true
+		} else if !os.IsNotExist(err) {
+			return fmt.Errorf("Could not read %s: %w", file.path, err)
+		}
+	}
+
+	// Write the new files next to their targets
+	for i, file := range b.files {
+		if err := ioutil.WriteFile(file.path+batchTempFileSuffix, file.data, file.mode); err != nil {
+			b.removeTempFiles(i + 1)
+			return fmt.Errorf("Could not write %s: %w", file.path, err)
+		}
+	}
+
+	// Move the new files over their targets
+	for i, file := range b.files {
+		if err := os.Rename(file.path+batchTempFileSuffix, file.path); err != nil {
+			err = fmt.Errorf("Could not replace %s: %w", file.path, err)
+			b.removeTempFiles(len(b.files))
+			if rollbackErr := b.rollback(i); rollbackErr != nil {
+				return fmt.Errorf("%w; restoring the replaced files also failed: %s", err, rollbackErr.Error())
+			}
+			return err
+		}
+	}
+
+	// Return
+	return nil
+
+}
+
+// Restore the first count files of the batch to their contents before the commit
+func (b *FileBatch) rollback(count int) error {
+	failed := []string{}
+	for _, file := range b.files[:count] {
+		var err error
+		if file.existed {
+			err = writeFileAtomic(file.path, file.original, file.mode)
+		} else {
+			err = os.Remove(file.path)
+		}
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s (%s)", file.path, err.Error()))
+		}
+	}
+	if len(failed) > 0 {
+		return errors.New(strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// Remove the temporary files of the first count files of the batch
+func (b *FileBatch) removeTempFiles(count int) {
+	for _, file := range b.files[:count] {
+		_ = os.Remove(file.path + batchTempFileSuffix)
+	}
+}
+
+// Write a file by writing it next to its target and moving it over the target
+func writeFileAtomic(path string, data []byte, mode os.FileMode) error {
+	if err := ioutil.WriteFile(path+batchTempFileSuffix, data, mode); err != nil {
+		return err
+	}
+	return os.Rename(path+batchTempFileSuffix, path)
+}
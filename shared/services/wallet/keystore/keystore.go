@@ -20,8 +20,14 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package keystore
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
 	"github.com/sethvargo/go-password/password"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 )
 
 // Generates a random password
@@ -41,3 +47,58 @@ type Keystore interface {
 	StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error
 	GetKeystoreDir() string
 }
+
+// Keystore whose validator keys can be re-encrypted with new random passwords
+type PasswordRotator interface {
+	// Add the re-encrypted validator keys and their new password files to the batch
+	RotatePasswords(batch *FileBatch) error
+}
+
+// Re-encrypt an EIP-2335 keystore file with a new random password, adding it and its password file to the batch.
+// The other keystore fields are kept as they are.
+func RotateKeystorePassword(batch *FileBatch, encryptor *eth2ks.Encryptor, keyFilePath string, passwordFilePath string, fileMode os.FileMode) error {
+
+	// Read the keystore & its password
+	keyBytes, err := ioutil.ReadFile(keyFilePath)
+	if err != nil {
+		return fmt.Errorf("Could not read validator key %s: %w", keyFilePath, err)
+	}
+	passwordBytes, err := ioutil.ReadFile(passwordFilePath)
+	if err != nil {
+		return fmt.Errorf("Could not read validator secret %s: %w", passwordFilePath, err)
+	}
+
+	// Decode & decrypt the keystore
+	var keystore map[string]interface{}
+	if err := json.Unmarshal(keyBytes, &keystore); err != nil {
+		return fmt.Errorf("Could not decode validator key %s: %w", keyFilePath, err)
+	}
+	crypto, ok := keystore["crypto"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Validator key %s has no crypto section", keyFilePath)
+	}
+	secret, err := encryptor.Decrypt(crypto, string(passwordBytes))
+	if err != nil {
+		return fmt.Errorf("Could not decrypt validator key %s: %w", keyFilePath, err)
+	}
+
+	// Re-encrypt it with a new password
+	newPassword, err := GenerateRandomPassword()
+	if err != nil {
+		return fmt.Errorf("Could not generate random password: %w", err)
+	}
+	keystore["crypto"], err = encryptor.Encrypt(secret, newPassword)
+	if err != nil {
+		return fmt.Errorf("Could not encrypt validator key %s: %w", keyFilePath, err)
+	}
+	keyBytes, err = json.Marshal(keystore)
+	if err != nil {
+		return fmt.Errorf("Could not encode validator key %s: %w", keyFilePath, err)
+	}
+
+	// Add the keystore & its password to the batch
+	batch.Add(keyFilePath, keyBytes, fileMode)
+	batch.Add(passwordFilePath, []byte(newPassword), fileMode)
+	return nil
+
+}
@@ -139,3 +139,35 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
 	return nil
 
 }
+
+// Re-encrypt every validator key with a new random password, adding the keys and their secrets to the batch
+func (ks *Keystore) RotatePasswords(batch *keystore.FileBatch) error {
+
+	// Get the validator key folders; there are no keys if the validators folder doesn't exist
+	validatorsPath := filepath.Join(ks.keystorePath, KeystoreDir, ValidatorsDir)
+	entries, err := ioutil.ReadDir(validatorsPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("Could not read validator keys folder: %w", err)
+	}
+
+	// Re-encrypt each key
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		keyFilePath := filepath.Join(validatorsPath, entry.Name(), KeyFileName)
+		if _, err := os.Stat(keyFilePath); os.IsNotExist(err) {
+			continue
+		}
+		secretFilePath := filepath.Join(ks.keystorePath, KeystoreDir, SecretsDir, entry.Name())
+		if err := keystore.RotateKeystorePassword(batch, ks.encryptor, keyFilePath, secretFilePath, FileMode); err != nil {
+			return err
+		}
+	}
+
+	// Return
+	return nil
+
+}
@@ -253,3 +253,54 @@ func (ks *Keystore) initialize() error {
 	return nil
 
 }
+
+// Re-encrypt the account store with a new random password, adding it and its password file to the batch
+func (ks *Keystore) RotatePasswords(batch *staderkeystore.FileBatch) error {
+
+	// Cancel if there is no account store yet
+	keystoreFilePath := filepath.Join(ks.keystorePath, KeystoreDir, WalletDir, AccountsDir, KeystoreFileName)
+	if _, err := os.Stat(keystoreFilePath); os.IsNotExist(err) {
+		return nil
+	}
+
+	// Initialize the account store
+	if err := ks.initialize(); err != nil {
+		return err
+	}
+
+	// Encode account store
+	asBytes, err := json.Marshal(ks.as)
+	if err != nil {
+		return fmt.Errorf("Could not encode validator account store: %w", err)
+	}
+
+	// Create a new password
+	password, err := staderkeystore.GenerateRandomPassword()
+	if err != nil {
+		return fmt.Errorf("Could not generate random password: %w", err)
+	}
+
+	// Encrypt account store
+	asEncrypted, err := ks.encryptor.Encrypt(asBytes, password)
+	if err != nil {
+		return fmt.Errorf("Could not encrypt validator account store: %w", err)
+	}
+
+	// Create & encode new keystore
+	ksBytes, err := json.Marshal(validatorKeystore{
+		Crypto:  asEncrypted,
+		Name:    ks.encryptor.Name(),
+		Version: ks.encryptor.Version(),
+		UUID:    uuid.New(),
+	})
+	if err != nil {
+		return fmt.Errorf("Could not encode validator keystore: %w", err)
+	}
+
+	// Add the keystore & its password to the batch
+	passwordFilePath := filepath.Join(ks.keystorePath, KeystoreDir, WalletDir, AccountsDir, KeystorePasswordFileName)
+	batch.Add(keystoreFilePath, ksBytes, FileMode)
+	batch.Add(passwordFilePath, []byte(password), FileMode)
+	return nil
+
+}
@@ -25,6 +25,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/google/uuid"
 	stadertypes "github.com/stader-labs/stader-node/stader-lib/types"
@@ -138,3 +139,32 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
 	return nil
 
 }
+
+// Re-encrypt every validator key with a new random password, adding the keys and their password files to the batch
+func (ks *Keystore) RotatePasswords(batch *keystore.FileBatch) error {
+
+	// Get the validator key files; there are no keys if the keys folder doesn't exist
+	validatorsPath := filepath.Join(ks.keystorePath, KeystoreDir, ValidatorsDir)
+	entries, err := ioutil.ReadDir(validatorsPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("Could not read validator keys folder: %w", err)
+	}
+
+	// Re-encrypt each key
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		keyFilePath := filepath.Join(validatorsPath, entry.Name())
+		secretFilePath := filepath.Join(ks.keystorePath, KeystoreDir, SecretsDir, strings.TrimSuffix(entry.Name(), ".json")+".txt")
+		if err := keystore.RotateKeystorePassword(batch, ks.encryptor, keyFilePath, secretFilePath, FileMode); err != nil {
+			return err
+		}
+	}
+
+	// Return
+	return nil
+
+}
@@ -0,0 +1,121 @@
+package web3signer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	stadertypes "github.com/stader-labs/stader-node/stader-lib/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	"github.com/stader-labs/stader-node/shared/services/keymanager"
+	keystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore"
+	"github.com/stader-labs/stader-node/shared/services/web3signer"
+)
+
+// Gets a client for the Keymanager API of the validator client, used to load remote keys into it
+type KeymanagerGetter func() (*keymanager.Client, error)
+
+// Web3Signer keystore.
+// Validator keys are kept in the Web3Signer instead of the validator client folder.
+type Keystore struct {
+	client        *web3signer.Client
+	importKeys    bool
+	getKeymanager KeymanagerGetter
+	encryptor     *eth2ks.Encryptor
+}
+
+// Encrypted validator key store
+type validatorKey struct {
+	Crypto  map[string]interface{}      `json:"crypto"`
+	Version uint                        `json:"version"`
+	UUID    uuid.UUID                   `json:"uuid"`
+	Path    string                      `json:"path"`
+	Pubkey  stadertypes.ValidatorPubkey `json:"pubkey"`
+}
+
+// Create new Web3Signer keystore.
+// If importKeys is false, keys must already be loaded in the Web3Signer.
+// If getKeymanager is set, every key is also loaded into the validator client as a remote key; leave it nil for clients that find the Web3Signer keys themselves.
+func NewKeystore(client *web3signer.Client, importKeys bool, getKeymanager KeymanagerGetter) *Keystore {
+	return &Keystore{
+		client:        client,
+		importKeys:    importKeys,
+		getKeymanager: getKeymanager,
+		encryptor:     eth2ks.New(eth2ks.WithCipher("scrypt")),
+	}
+}
+
+// Get the keystore directory. Keys are not stored locally, so there is nothing to delete.
+func (ks *Keystore) GetKeystoreDir() string {
+	return ""
+}
+
+// Store a validator key
+func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error {
+
+	// Get validator pubkey
+	pubkey := stadertypes.BytesToValidatorPubkey(key.PublicKey().Marshal())
+
+	if ks.importKeys {
+		if err := ks.importKey(key, pubkey, derivationPath); err != nil {
+			return err
+		}
+	} else {
+		loaded, err := ks.client.HasPublicKey(pubkey)
+		if err != nil {
+			return err
+		}
+		if !loaded {
+			return fmt.Errorf("Validator key %s is not loaded in the Web3Signer at %s; load it there first, or enable importing keys into the Web3Signer", pubkey.Hex(), ks.client.GetProviderAddress())
+		}
+	}
+
+	// Point the validator client at the Web3Signer for this key
+	if ks.getKeymanager != nil {
+		km, err := ks.getKeymanager()
+		if err != nil {
+			return fmt.Errorf("Could not load the Web3Signer key into the validator client: %w", err)
+		}
+		if err := km.ImportRemoteKey(pubkey, ks.client.GetProviderAddress()); err != nil {
+			return err
+		}
+	}
+
+	// Return
+	return nil
+
+}
+
+// Encrypt a validator key and import it into the Web3Signer
+func (ks *Keystore) importKey(key *eth2types.BLSPrivateKey, pubkey stadertypes.ValidatorPubkey, derivationPath string) error {
+
+	// Create a new password
+	password, err := keystore.GenerateRandomPassword()
+	if err != nil {
+		return fmt.Errorf("Could not generate random password: %w", err)
+	}
+
+	// Encrypt key
+	encryptedKey, err := ks.encryptor.Encrypt(key.Marshal(), password)
+	if err != nil {
+		return fmt.Errorf("Could not encrypt validator key: %w", err)
+	}
+
+	// Encode key store
+	keyStoreBytes, err := json.Marshal(validatorKey{
+		Crypto:  encryptedKey,
+		Version: ks.encryptor.Version(),
+		UUID:    uuid.New(),
+		Path:    derivationPath,
+		Pubkey:  pubkey,
+	})
+	if err != nil {
+		return fmt.Errorf("Could not encode validator key: %w", err)
+	}
+
+	// Web3Signer keeps the password with the key, so it is not stored locally
+	return ks.client.ImportKeystore(string(keyStoreBytes), password)
+
+}
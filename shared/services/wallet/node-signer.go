@@ -0,0 +1,39 @@
+package wallet
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Returned for operations that need the node account private key while it is held by an external signer
+var ErrExternalNodeSigner = errors.New("The node account key is held by an external signer and can't be used by the node")
+
+// Signs transactions and messages for the node account outside the node wallet
+type NodeSigner interface {
+	// Get the node account address
+	GetAddress() common.Address
+
+	// Get where the node account key is held
+	GetUrl() accounts.URL
+
+	// Sign a transaction for the given chain
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+
+	// Sign a text message (EIP-191) with a 'v' value of 27 or 28
+	SignText(message []byte) ([]byte, error)
+}
+
+// Hand the node account over to an external signer.
+// The node wallet is still used for the validator keys.
+func (w *Wallet) SetNodeSigner(signer NodeSigner) {
+	w.nodeSigner = signer
+}
+
+// Check if the node account is held by an external signer
+func (w *Wallet) HasExternalNodeSigner() bool {
+	return w.nodeSigner != nil
+}
@@ -29,6 +29,8 @@ import (
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -40,6 +42,14 @@ func (w *Wallet) GetNodeAccount() (accounts.Account, error) {
 		return accounts.Account{}, errors.New("Wallet is not initialized")
 	}
 
+	// Get the account from the external signer
+	if w.nodeSigner != nil {
+		return accounts.Account{
+			Address: w.nodeSigner.GetAddress(),
+			URL:     w.nodeSigner.GetUrl(),
+		}, nil
+	}
+
 	// Get private key
 	privateKey, path, err := w.getNodePrivateKey()
 	if err != nil {
@@ -72,6 +82,24 @@ func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 		return nil, errors.New("Wallet is not initialized")
 	}
 
+	// Create a transactor that signs with the external signer
+	if w.nodeSigner != nil {
+		from := w.nodeSigner.GetAddress()
+		return &bind.TransactOpts{
+			From: from,
+			Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				if address != from {
+					return nil, bind.ErrNotAuthorized
+				}
+				return w.nodeSigner.SignTx(tx, w.chainID)
+			},
+			GasFeeCap: w.maxFee,
+			GasTipCap: w.maxPriorityFee,
+			GasLimit:  w.gasLimit,
+			Context:   context.Background(),
+		}, nil
+	}
+
 	// Get private key
 	privateKey, _, err := w.getNodePrivateKey()
 	if err != nil {
@@ -93,6 +121,9 @@ func (w *Wallet) GetNodePrivateKey() (*ecdsa.PrivateKey, error) {
 	if !w.IsInitialized() {
 		return nil, errors.New("Wallet is not initialized")
 	}
+	if w.nodeSigner != nil {
+		return nil, ErrExternalNodeSigner
+	}
 
 	// Get private key
 	privateKey, _, err := w.getNodePrivateKey()
@@ -110,6 +141,9 @@ func (w *Wallet) GetNodePrivateKeyBytes() ([]byte, error) {
 	if !w.IsInitialized() {
 		return nil, errors.New("Wallet is not initialized")
 	}
+	if w.nodeSigner != nil {
+		return nil, ErrExternalNodeSigner
+	}
 
 	// Get private key
 	privateKey, _, err := w.getNodePrivateKey()
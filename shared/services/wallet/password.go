@@ -0,0 +1,103 @@
+package wallet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/stader-labs/stader-node/shared/services/passwords"
+	"github.com/stader-labs/stader-node/shared/services/wallet/keystore"
+)
+
+// Change the wallet password.
+// The wallet store and imported validator keys are re-encrypted with the new password, and every validator keystore is re-encrypted with new random passwords.
+// All files are replaced together; if any of them can't be replaced, the ones already replaced are restored.
+func (w *Wallet) ChangePassword(currentPassword string, newPassword string) error {
+
+	// Check wallet is initialized
+	if !w.IsInitialized() {
+		return errors.New("Wallet is not initialized")
+	}
+
+	// Check the new password
+	if len(newPassword) < passwords.MinPasswordLength {
+		return fmt.Errorf("Password must be at least %d characters long", passwords.MinPasswordLength)
+	}
+	if newPassword == currentPassword {
+		return errors.New("The new password is the same as the current password")
+	}
+
+	// Verify the current password
+	password, err := w.pm.GetPassword()
+	if err != nil {
+		return fmt.Errorf("Could not get wallet password: %w", err)
+	}
+	if currentPassword != password {
+		return errors.New("The current password is incorrect")
+	}
+	seed, err := w.encryptor.Decrypt(w.ws.Crypto, currentPassword)
+	if err != nil {
+		return fmt.Errorf("Could not decrypt wallet seed with the current password: %w", err)
+	}
+
+	// Re-encrypt validator keystores
+	batch := &keystore.FileBatch{}
+	for name, ks := range w.keystores {
+		rotator, ok := ks.(keystore.PasswordRotator)
+		if !ok {
+			continue
+		}
+		if err := rotator.RotatePasswords(batch); err != nil {
+			return fmt.Errorf("Could not re-encrypt %s validator keystore: %w", name, err)
+		}
+	}
+
+	// Re-encrypt imported validator keys
+	importedKeys := make(map[string]importedKey, len(w.importedKeys))
+	for pubkeyHex, imported := range w.importedKeys {
+		key, err := w.getImportedValidatorKey(imported.PublicKey)
+		if err != nil {
+			return err
+		}
+		imported.Crypto, err = w.encryptor.Encrypt(key.Marshal(), newPassword)
+		if err != nil {
+			return fmt.Errorf("Could not encrypt imported validator %s key: %w", pubkeyHex, err)
+		}
+		importedKeys[pubkeyHex] = imported
+	}
+	if len(importedKeys) > 0 {
+		storeBytes, err := w.encodeImportedKeys(importedKeys)
+		if err != nil {
+			return err
+		}
+		batch.Add(w.getImportedKeysPath(), storeBytes, FileMode)
+	}
+
+	// Re-encrypt wallet store
+	ws := *w.ws
+	ws.Crypto, err = w.encryptor.Encrypt(seed, newPassword)
+	if err != nil {
+		return fmt.Errorf("Could not encrypt wallet seed: %w", err)
+	}
+	wsBytes, err := json.Marshal(ws)
+	if err != nil {
+		return fmt.Errorf("Could not encode wallet: %w", err)
+	}
+	batch.Add(w.walletPath, wsBytes, FileMode)
+
+	// Replace the password
+	batch.Add(w.pm.GetPasswordPath(), []byte(newPassword), passwords.FileMode)
+
+	// Write every file
+	if err := batch.Commit(); err != nil {
+		return fmt.Errorf("Could not write re-encrypted wallet files: %w", err)
+	}
+
+	// Update the wallet
+	w.ws = &ws
+	w.importedKeys = importedKeys
+
+	// Return
+	return nil
+
+}
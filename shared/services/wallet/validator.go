@@ -93,6 +93,13 @@ func (w *Wallet) GetValidatorKeyByPubkey(pubkey stadertypes.ValidatorPubkey) (*e
 		}
 	}
 
+	// Check for an imported validator key
+	if key, err := w.getImportedValidatorKey(pubkey); err != nil {
+		return nil, err
+	} else if key != nil {
+		return key, nil
+	}
+
 	// Find matching validator key
 	var index uint
 	var validatorKey *eth2types.BLSPrivateKey
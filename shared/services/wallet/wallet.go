@@ -71,10 +71,17 @@ type Wallet struct {
 	nodeKey     *ecdsa.PrivateKey
 	nodeKeyPath string
 
+	// External signer for the node account, if the node key is not held by the wallet
+	nodeSigner NodeSigner
+
 	// Validator key caches
 	validatorKeys       map[uint]*eth2types.BLSPrivateKey
 	validatorKeyIndices map[string]uint
 
+	// Validator keys imported from EIP-2335 keystores, and a cache of the decrypted ones
+	importedKeys     map[string]importedKey
+	importedKeyCache map[string]*eth2types.BLSPrivateKey
+
 	// Keystores
 	keystores map[string]keystore.Keystore
 
@@ -106,6 +113,8 @@ func NewWallet(walletPath string, chainId uint, maxFee *big.Int, maxPriorityFee
 		chainID:             big.NewInt(int64(chainId)),
 		validatorKeys:       map[uint]*eth2types.BLSPrivateKey{},
 		validatorKeyIndices: map[string]uint{},
+		importedKeys:        map[string]importedKey{},
+		importedKeyCache:    map[string]*eth2types.BLSPrivateKey{},
 		keystores:           map[string]keystore.Keystore{},
 		maxFee:              maxFee,
 		maxPriorityFee:      maxPriorityFee,
@@ -286,6 +295,11 @@ func (w *Wallet) Delete() error {
 		return fmt.Errorf("error checking wallet file path: %w", err)
 	}
 
+	// Delete the imported validator keys with the wallet
+	if err := w.deleteImportedKeys(); err != nil {
+		return err
+	}
+
 	// Write wallet store to disk
 	err = os.Remove(w.walletPath)
 	return err
@@ -294,20 +308,24 @@ func (w *Wallet) Delete() error {
 
 // Signs a serialized TX using the wallet's private key
 func (w *Wallet) Sign(serializedTx []byte) ([]byte, error) {
-	// Get private key
-	privateKey, _, err := w.getNodePrivateKey()
-	if err != nil {
-		return nil, err
-	}
-
 	tx := types.Transaction{}
-	err = tx.UnmarshalBinary(serializedTx)
+	err := tx.UnmarshalBinary(serializedTx)
 	if err != nil {
 		return nil, fmt.Errorf("Error unmarshalling TX: %w", err)
 	}
 
-	signer := types.NewLondonSigner(w.chainID)
-	signedTx, err := types.SignTx(&tx, signer, privateKey)
+	var signedTx *types.Transaction
+	if w.nodeSigner != nil {
+		signedTx, err = w.nodeSigner.SignTx(&tx, w.chainID)
+	} else {
+		// Get private key
+		var privateKey *ecdsa.PrivateKey
+		privateKey, _, err = w.getNodePrivateKey()
+		if err != nil {
+			return nil, err
+		}
+		signedTx, err = types.SignTx(&tx, types.NewLondonSigner(w.chainID), privateKey)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("Error signing TX: %w", err)
 	}
@@ -322,6 +340,14 @@ func (w *Wallet) Sign(serializedTx []byte) ([]byte, error) {
 
 // Signs an arbitrary message using the wallet's private key
 func (w *Wallet) SignMessage(message string) ([]byte, error) {
+	if w.nodeSigner != nil {
+		signedMessage, err := w.nodeSigner.SignText([]byte(message))
+		if err != nil {
+			return nil, fmt.Errorf("Error signing message: %w", err)
+		}
+		return signedMessage, nil
+	}
+
 	// Get the wallet's private key
 	privateKey, _, err := w.getNodePrivateKey()
 	if err != nil {
@@ -383,6 +409,11 @@ func (w *Wallet) loadStore() (bool, error) {
 		return false, fmt.Errorf("Could not create wallet master key: %w", err)
 	}
 
+	// Load imported validator keys
+	if err := w.loadImportedKeys(); err != nil {
+		return false, err
+	}
+
 	// Return
 	return true, nil
 
@@ -0,0 +1,215 @@
+package web3signer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	hexutil "github.com/stader-labs/stader-node/shared/utils/hex"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Config
+const (
+	RequestUrlFormat   = "%s%s"
+	RequestContentType = "application/json"
+
+	RequestPublicKeysPath = "/api/v1/eth2/publicKeys"
+	RequestSignPath       = "/api/v1/eth2/sign/%s"
+	RequestKeystoresPath  = "/eth/v1/keystores"
+
+	SignType_VoluntaryExit = "VOLUNTARY_EXIT"
+
+	ImportStatus_Imported  = "imported"
+	ImportStatus_Duplicate = "duplicate"
+
+	requestTimeout = 30 * time.Second
+)
+
+// Client for a Web3Signer remote signer
+type Client struct {
+	providerAddress string
+	httpClient      *http.Client
+}
+
+// Create a new client for the Web3Signer at the given address
+func NewClient(providerAddress string) *Client {
+	return &Client{
+		providerAddress: strings.TrimSuffix(providerAddress, "/"),
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+// Get the address of the Web3Signer
+func (c *Client) GetProviderAddress() string {
+	return c.providerAddress
+}
+
+// Get the public keys of the validator keys loaded in the Web3Signer
+func (c *Client) GetPublicKeys() ([]types.ValidatorPubkey, error) {
+	responseBody, status, err := c.sendRequest(http.MethodGet, RequestPublicKeysPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get Web3Signer public keys: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Could not get Web3Signer public keys: %s", getErrorMessage(status, responseBody))
+	}
+	var response []string
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode Web3Signer public keys: %w", err)
+	}
+
+	pubkeys := make([]types.ValidatorPubkey, len(response))
+	for i, pubkeyString := range response {
+		pubkey, err := types.HexToValidatorPubkey(hexutil.RemovePrefix(pubkeyString))
+		if err != nil {
+			return nil, fmt.Errorf("Could not decode Web3Signer public key %s: %w", pubkeyString, err)
+		}
+		pubkeys[i] = pubkey
+	}
+	return pubkeys, nil
+}
+
+// Check if a validator key is loaded in the Web3Signer
+func (c *Client) HasPublicKey(pubkey types.ValidatorPubkey) (bool, error) {
+	pubkeys, err := c.GetPublicKeys()
+	if err != nil {
+		return false, err
+	}
+	for _, loadedPubkey := range pubkeys {
+		if loadedPubkey == pubkey {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Import an EIP-2335 keystore into the Web3Signer through its Keymanager API.
+// Importing a key that is already loaded is not an error.
+func (c *Client) ImportKeystore(keystore string, password string) error {
+	responseBody, status, err := c.sendRequest(http.MethodPost, RequestKeystoresPath, ImportKeystoresRequest{
+		Keystores: []string{keystore},
+		Passwords: []string{password},
+	})
+	if err != nil {
+		return fmt.Errorf("Could not import keystore into Web3Signer: %w", err)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Could not import keystore into Web3Signer: %s", getErrorMessage(status, responseBody))
+	}
+	var response ImportKeystoresResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return fmt.Errorf("Could not decode Web3Signer keystore import response: %w", err)
+	}
+	if len(response.Data) != 1 {
+		return fmt.Errorf("Web3Signer returned %d import statuses for 1 keystore", len(response.Data))
+	}
+	switch response.Data[0].Status {
+	case ImportStatus_Imported, ImportStatus_Duplicate:
+		return nil
+	default:
+		return fmt.Errorf("Web3Signer did not import the keystore: %s (%s)", response.Data[0].Status, response.Data[0].Message)
+	}
+}
+
+// Sign a voluntary exit message with a validator key loaded in the Web3Signer.
+// The exit is signed with the given fork version; the signing root lets the Web3Signer check it computes the same message.
+func (c *Client) SignVoluntaryExit(pubkey types.ValidatorPubkey, validatorIndex uint64, epoch uint64, forkVersion []byte, genesisValidatorsRoot []byte, signingRoot [32]byte) (types.ValidatorSignature, error) {
+	version := hexutil.AddPrefix(fmt.Sprintf("%x", forkVersion))
+	request := SignRequest{
+		Type: SignType_VoluntaryExit,
+		ForkInfo: ForkInfo{
+			Fork: Fork{
+				PreviousVersion: version,
+				CurrentVersion:  version,
+				Epoch:           "0",
+			},
+			GenesisValidatorsRoot: hexutil.AddPrefix(fmt.Sprintf("%x", genesisValidatorsRoot)),
+		},
+		SigningRoot: hexutil.AddPrefix(fmt.Sprintf("%x", signingRoot)),
+		VoluntaryExit: &VoluntaryExit{
+			Epoch:          strconv.FormatUint(epoch, 10),
+			ValidatorIndex: strconv.FormatUint(validatorIndex, 10),
+		},
+	}
+
+	responseBody, status, err := c.sendRequest(http.MethodPost, fmt.Sprintf(RequestSignPath, hexutil.AddPrefix(pubkey.Hex())), request)
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("Could not sign voluntary exit of validator %s with Web3Signer: %w", pubkey.Hex(), err)
+	}
+	if status != http.StatusOK {
+		return types.ValidatorSignature{}, fmt.Errorf("Could not sign voluntary exit of validator %s with Web3Signer: %s", pubkey.Hex(), getErrorMessage(status, responseBody))
+	}
+
+	// Older Web3Signer versions reply with the plain signature instead of JSON
+	signatureString := strings.TrimSpace(string(responseBody))
+	var response SignResponse
+	if err := json.Unmarshal(responseBody, &response); err == nil {
+		signatureString = response.Signature
+	}
+	signature, err := types.HexToValidatorSignature(hexutil.RemovePrefix(signatureString))
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("Could not decode Web3Signer signature for validator %s: %w", pubkey.Hex(), err)
+	}
+	return signature, nil
+}
+
+// Make a request to the Web3Signer
+func (c *Client) sendRequest(method string, requestPath string, requestBody interface{}) ([]byte, int, error) {
+
+	// Get request body
+	var requestBodyReader io.Reader
+	if requestBody != nil {
+		requestBodyBytes, err := json.Marshal(requestBody)
+		if err != nil {
+			return []byte{}, 0, err
+		}
+		requestBodyReader = bytes.NewReader(requestBodyBytes)
+	}
+
+	// Build request
+	request, err := http.NewRequest(method, fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), requestBodyReader)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	request.Header.Set("Accept", RequestContentType)
+	if requestBody != nil {
+		request.Header.Set("Content-Type", RequestContentType)
+	}
+
+	// Send request
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	// Get response
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+
+	// Return
+	return body, response.StatusCode, nil
+
+}
+
+// Get a readable error from a failed Web3Signer response
+func getErrorMessage(status int, responseBody []byte) string {
+	var response ErrorResponse
+	if err := json.Unmarshal(responseBody, &response); err == nil && response.Message != "" {
+		return fmt.Sprintf("HTTP status %d; %s", status, response.Message)
+	}
+	return fmt.Sprintf("HTTP status %d; response body: '%s'", status, string(responseBody))
+}
@@ -0,0 +1,41 @@
+package web3signer
+
+// Request types
+type ImportKeystoresRequest struct {
+	Keystores []string `json:"keystores"`
+	Passwords []string `json:"passwords"`
+}
+type SignRequest struct {
+	Type          string         `json:"type"`
+	ForkInfo      ForkInfo       `json:"fork_info"`
+	SigningRoot   string         `json:"signingRoot,omitempty"`
+	VoluntaryExit *VoluntaryExit `json:"voluntary_exit,omitempty"`
+}
+type ForkInfo struct {
+	Fork                  Fork   `json:"fork"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+type Fork struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+type VoluntaryExit struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// Response types
+type ImportKeystoresResponse struct {
+	Data []ImportStatus `json:"data"`
+}
+type ImportStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+type SignResponse struct {
+	Signature string `json:"signature"`
+}
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
@@ -1,6 +1,9 @@
 package api
 
 import (
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/services/events"
+	"github.com/stader-labs/stader-node/shared/services/txs"
 	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
 	"math/big"
 	"time"
@@ -43,6 +46,8 @@ type NodeStatusResponse struct {
 	TotalValidatorClRewards           *big.Int                           `json:"totalValidatorClRewards"`
 	ClaimedSocializingPoolMerkles     []stader_backend.CycleMerkleProofs `json:"claimedSocializingPoolMerkles"`
 	UnclaimedSocializingPoolMerkles   []stader_backend.CycleMerkleProofs `json:"unclaimedSocializingPoolMerkles"`
+	SnapshotElBlockNumber             uint64                             `json:"snapshotElBlockNumber"`
+	SnapshotBeaconSlot                uint64                             `json:"snapshotBeaconSlot"`
 }
 
 type CanRegisterNodeResponse struct {
@@ -56,9 +61,10 @@ type CanRegisterNodeResponse struct {
 }
 
 type RegisterNodeResponse struct {
-	Status string      `json:"status"`
-	Error  string      `json:"error"`
-	TxHash common.Hash `json:"txHash"`
+	Status     string                   `json:"status"`
+	Error      string                   `json:"error"`
+	TxHash     common.Hash              `json:"txHash"`
+	UnsignedTx *txs.UnsignedTransaction `json:"unsignedTx,omitempty"`
 }
 
 type CanNodeDepositSdResponse struct {
@@ -74,14 +80,16 @@ type NodeDepositSdApproveGasResponse struct {
 	GasInfo stader.GasInfo `json:"gasInfo"`
 }
 type NodeDepositSdApproveResponse struct {
-	Status        string      `json:"status"`
-	Error         string      `json:"error"`
-	ApproveTxHash common.Hash `json:"approveTxHash"`
+	Status        string                   `json:"status"`
+	Error         string                   `json:"error"`
+	ApproveTxHash common.Hash              `json:"approveTxHash"`
+	UnsignedTx    *txs.UnsignedTransaction `json:"unsignedTx,omitempty"`
 }
 type NodeDepositSdResponse struct {
-	Status        string      `json:"status"`
-	Error         string      `json:"error"`
-	DepositTxHash common.Hash `json:"stakeTxHash"`
+	Status        string                   `json:"status"`
+	Error         string                   `json:"error"`
+	DepositTxHash common.Hash              `json:"stakeTxHash"`
+	UnsignedTx    *txs.UnsignedTransaction `json:"unsignedTx,omitempty"`
 }
 type NodeDepositSdAllowanceResponse struct {
 	Status    string   `json:"status"`
@@ -104,9 +112,10 @@ type CanNodeDepositResponse struct {
 }
 
 type NodeDepositResponse struct {
-	Status string      `json:"status"`
-	Error  string      `json:"error"`
-	TxHash common.Hash `json:"txHash"`
+	Status     string                   `json:"status"`
+	Error      string                   `json:"error"`
+	TxHash     common.Hash              `json:"txHash"`
+	UnsignedTx *txs.UnsignedTransaction `json:"unsignedTx,omitempty"`
 }
 
 type CanNodeSendResponse struct {
@@ -195,6 +204,71 @@ type ExitValidatorResponse struct {
 	Error          string `json:"error"`
 }
 
+type ValidatorPresignStatus struct {
+	Pubkey                  types.ValidatorPubkey `json:"pubkey"`
+	ContractStatus          uint8                 `json:"contractStatus"`
+	ContractStatusToDisplay string                `json:"contractStatusToDisplay"`
+	ExistsOnBeaconChain     bool                  `json:"existsOnBeaconChain"`
+	BeaconStatus            beacon.ValidatorState `json:"beaconStatus"`
+	PresignRegistered       bool                  `json:"presignRegistered"`
+	PresignMissing          bool                  `json:"presignMissing"`
+}
+
+type PresignStatusResponse struct {
+	Status              string                   `json:"status"`
+	Error               string                   `json:"error"`
+	Validators          []ValidatorPresignStatus `json:"validators"`
+	MissingPresignCount int                      `json:"missingPresignCount"`
+}
+
+type VerifiedExit struct {
+	Pubkey         types.ValidatorPubkey `json:"pubkey"`
+	ValidatorIndex uint64                `json:"validatorIndex"`
+	Epoch          uint64                `json:"epoch"`
+	SignedAt       time.Time             `json:"signedAt"`
+	SignedWithFork string                `json:"signedWithFork"`
+	ExpectedFork   string                `json:"expectedFork"`
+	Usable         bool                  `json:"usable"`
+	Problems       []string              `json:"problems"`
+}
+
+type VerifyExitsResponse struct {
+	Status        string         `json:"status"`
+	Error         string         `json:"error"`
+	StoreEnabled  bool           `json:"storeEnabled"`
+	CurrentEpoch  uint64         `json:"currentEpoch"`
+	Exits         []VerifiedExit `json:"exits"`
+	UnusableCount int            `json:"unusableCount"`
+}
+
+// Consensus duties and rewards of a validator over an epoch range, rewards are in gwei
+type ValidatorPerformance struct {
+	Pubkey                   types.ValidatorPubkey `json:"pubkey"`
+	Index                    uint64                `json:"index"`
+	AttestationDuties        uint64                `json:"attestationDuties"`
+	MissedSources            uint64                `json:"missedSources"`
+	MissedTargets            uint64                `json:"missedTargets"`
+	MissedHeads              uint64                `json:"missedHeads"`
+	AttestationRewards       int64                 `json:"attestationRewards"`
+	IdealAttestationRewards  int64                 `json:"idealAttestationRewards"`
+	AttestationEffectiveness float64               `json:"attestationEffectiveness"`
+	ProposalsScheduled       uint64                `json:"proposalsScheduled"`
+	ProposalsMissed          uint64                `json:"proposalsMissed"`
+	ProposalRewards          uint64                `json:"proposalRewards"`
+	SyncCommitteeDuties      uint64                `json:"syncCommitteeDuties"`
+	SyncCommitteeMissed      uint64                `json:"syncCommitteeMissed"`
+	SyncCommitteeRewards     int64                 `json:"syncCommitteeRewards"`
+}
+
+type ValidatorPerformanceResponse struct {
+	Status                            string                 `json:"status"`
+	Error                             string                 `json:"error"`
+	StartEpoch                        uint64                 `json:"startEpoch"`
+	EndEpoch                          uint64                 `json:"endEpoch"`
+	MissedAttestationPenaltyPerStrike *big.Int               `json:"missedAttestationPenaltyPerStrike"`
+	Validators                        []ValidatorPerformance `json:"validators"`
+}
+
 type CanUpdateSocializeElResponse struct {
 	Status                             string         `json:"status"`
 	Error                              string         `json:"error"`
@@ -207,9 +281,10 @@ type CanUpdateSocializeElResponse struct {
 }
 
 type UpdateSocializeElResponse struct {
-	Status string      `json:"status"`
-	Error  string      `json:"error"`
-	TxHash common.Hash `json:"txHash"`
+	Status     string                   `json:"status"`
+	Error      string                   `json:"error"`
+	TxHash     common.Hash              `json:"txHash"`
+	UnsignedTx *txs.UnsignedTransaction `json:"unsignedTx,omitempty"`
 }
 
 type CanSendClRewardsResponse struct {
@@ -230,6 +305,21 @@ type SendClRewardsResponse struct {
 	TxHash                common.Hash    `json:"txHash"`
 }
 
+type ClRewardsVault struct {
+	Pubkey               types.ValidatorPubkey `json:"pubkey"`
+	WithdrawVaultAddress common.Address        `json:"withdrawVaultAddress"`
+	Balance              *big.Int              `json:"balance"`
+	OperatorShare        *big.Int              `json:"operatorShare"`
+	State                string                `json:"state"`
+	GasInfo              stader.GasInfo        `json:"gasInfo"`
+}
+
+type CanSendAllClRewardsResponse struct {
+	Status string           `json:"status"`
+	Error  string           `json:"error"`
+	Vaults []ClRewardsVault `json:"vaults"`
+}
+
 type CanSettleExitFunds struct {
 	Status                 string         `json:"status"`
 	Error                  string         `json:"error"`
@@ -272,9 +362,10 @@ type CanWithdrawSdResponse struct {
 }
 
 type WithdrawSdResponse struct {
-	Status string      `json:"status"`
-	Error  string      `json:"error"`
-	TxHash common.Hash `json:"txHash"`
+	Status     string                   `json:"status"`
+	Error      string                   `json:"error"`
+	TxHash     common.Hash              `json:"txHash"`
+	UnsignedTx *txs.UnsignedTransaction `json:"unsignedTx,omitempty"`
 }
 
 type CanClaimSdResponse struct {
@@ -299,10 +390,17 @@ type CanDownloadSpMerkleProofsResponse struct {
 	CurrentCycle    int64   `json:"currentCycle"`
 }
 
+type QuarantinedMerkleProof struct {
+	Cycle   int64  `json:"cycle"`
+	Failure string `json:"failure"`
+	Reason  string `json:"reason"`
+}
+
 type DownloadSpMerkleProofsResponse struct {
-	Status           string  `json:"status"`
-	Error            string  `json:"error"`
-	DownloadedCycles []int64 `json:"downloadedCycles"`
+	Status            string                   `json:"status"`
+	Error             string                   `json:"error"`
+	DownloadedCycles  []int64                  `json:"downloadedCycles"`
+	QuarantinedCycles []QuarantinedMerkleProof `json:"quarantinedCycles"`
 }
 
 type DetailedMerkleProofInfo struct {
@@ -332,9 +430,10 @@ type EstimateClaimSpRewardsGasResponse struct {
 }
 
 type ClaimSpRewardsResponse struct {
-	Status string      `json:"status"`
-	Error  string      `json:"error"`
-	TxHash common.Hash `json:"txHash"`
+	Status     string                   `json:"status"`
+	Error      string                   `json:"error"`
+	TxHash     common.Hash              `json:"txHash"`
+	UnsignedTx *txs.UnsignedTransaction `json:"unsignedTx,omitempty"`
 }
 
 type CanUpdateOperatorDetails struct {
@@ -363,9 +462,10 @@ type CanUpdateOperatorName struct {
 }
 
 type UpdateOperatorName struct {
-	Status string      `json:"status"`
-	Error  string      `json:"error"`
-	TxHash common.Hash `json:"txHash"`
+	Status     string                   `json:"status"`
+	Error      string                   `json:"error"`
+	TxHash     common.Hash              `json:"txHash"`
+	UnsignedTx *txs.UnsignedTransaction `json:"unsignedTx,omitempty"`
 }
 
 type CanUpdateOperatorRewardAddress struct {
@@ -379,9 +479,10 @@ type CanUpdateOperatorRewardAddress struct {
 }
 
 type UpdateOperatorRewardAddress struct {
-	Status string      `json:"status"`
-	Error  string      `json:"error"`
-	TxHash common.Hash `json:"txHash"`
+	Status     string                   `json:"status"`
+	Error      string                   `json:"error"`
+	TxHash     common.Hash              `json:"txHash"`
+	UnsignedTx *txs.UnsignedTransaction `json:"unsignedTx,omitempty"`
 }
 
 type NodeSignResponse struct {
@@ -398,9 +499,48 @@ type CanClaimRewards struct {
 }
 
 type ClaimRewards struct {
-	Status                 string         `json:"status"`
-	Error                  string         `json:"error"`
-	OperatorRewardsBalance *big.Int       `json:"operatorRewardsBalance"`
-	OperatorRewardAddress  common.Address `json:"operatorRewardAddress"`
-	TxHash                 common.Hash    `json:"txHash"`
+	Status                 string                   `json:"status"`
+	Error                  string                   `json:"error"`
+	OperatorRewardsBalance *big.Int                 `json:"operatorRewardsBalance"`
+	OperatorRewardAddress  common.Address           `json:"operatorRewardAddress"`
+	TxHash                 common.Hash              `json:"txHash"`
+	UnsignedTx             *txs.UnsignedTransaction `json:"unsignedTx,omitempty"`
+}
+
+type PendingTransactionsResponse struct {
+	Status       string            `json:"status"`
+	Error        string            `json:"error"`
+	Transactions []txs.Transaction `json:"transactions"`
+}
+
+type CanReplaceTransactionResponse struct {
+	Status            string          `json:"status"`
+	Error             string          `json:"error"`
+	CanReplace        bool            `json:"canReplace"`
+	NotFound          bool            `json:"notFound"`
+	NotPending        bool            `json:"notPending"`
+	Transaction       txs.Transaction `json:"transaction"`
+	MinMaxFee         *big.Int        `json:"minMaxFee"`
+	MinMaxPriorityFee *big.Int        `json:"minMaxPriorityFee"`
+	GasInfo           stader.GasInfo  `json:"gasInfo"`
+}
+type ReplaceTransactionResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
+
+type NodeEventsResponse struct {
+	Status      string         `json:"status"`
+	Error       string         `json:"error"`
+	Indexed     bool           `json:"indexed"`
+	Cursor      events.Cursor  `json:"cursor"`
+	TotalEvents int            `json:"totalEvents"`
+	Events      []events.Event `json:"events"`
+}
+
+type BroadcastTxResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
 }
@@ -22,6 +22,7 @@ package api
 import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
+	"github.com/stader-labs/stader-node/shared/services/txs"
 	"github.com/stader-labs/stader-node/stader-lib/stader"
 	"github.com/stader-labs/stader-node/stader-lib/types"
 	"math/big"
@@ -38,13 +39,14 @@ type ValidatorKeystore struct {
 }
 
 type WalletStatusResponse struct {
-	Status            string         `json:"status"`
-	Error             string         `json:"error"`
-	PasswordSet       bool           `json:"passwordSet"`
-	WalletInitialized bool           `json:"walletInitialized"`
-	AccountAddress    common.Address `json:"accountAddress"`
-	CurrentNonce      *big.Int       `json:"currentNonce"`
-	PendingNonce      *big.Int       `json:"pendingNonce"`
+	Status            string                  `json:"status"`
+	Error             string                  `json:"error"`
+	PasswordSet       bool                    `json:"passwordSet"`
+	WalletInitialized bool                    `json:"walletInitialized"`
+	AccountAddress    common.Address          `json:"accountAddress"`
+	CurrentNonce      *big.Int                `json:"currentNonce"`
+	PendingNonce      *big.Int                `json:"pendingNonce"`
+	ImportedKeys      []types.ValidatorPubkey `json:"importedKeys"`
 }
 
 type SetPasswordResponse struct {
@@ -52,6 +54,12 @@ type SetPasswordResponse struct {
 	Error  string `json:"error"`
 }
 
+type ChangePasswordResponse struct {
+	Status             string `json:"status"`
+	Error              string `json:"error"`
+	RestartedValidator bool   `json:"restartedValidator"`
+}
+
 type InitWalletResponse struct {
 	Status         string         `json:"status"`
 	Error          string         `json:"error"`
@@ -90,6 +98,27 @@ type ExportWalletResponse struct {
 	AccountPrivateKey string `json:"accountPrivateKey"`
 }
 
+type ImportKeystoresResponse struct {
+	Status                    string                  `json:"status"`
+	Error                     string                  `json:"error"`
+	Keystores                 []ImportedKeystore      `json:"keystores"`
+	MissingSlashingProtection []types.ValidatorPubkey `json:"missingSlashingProtection"`
+	RestartedValidator        bool                    `json:"restartedValidator"`
+}
+type ReleaseImportedKeysResponse struct {
+	Status             string                  `json:"status"`
+	Error              string                  `json:"error"`
+	Released           []types.ValidatorPubkey `json:"released"`
+	Held               []types.ValidatorPubkey `json:"held"`
+	RestartedValidator bool                    `json:"restartedValidator"`
+}
+type ImportedKeystore struct {
+	Imported       bool                  `json:"imported"`
+	Error          string                `json:"error"`
+	Pubkey         types.ValidatorPubkey `json:"pubkey"`
+	DerivationPath string                `json:"derivationPath"`
+}
+
 type SetEnsNameResponse struct {
 	Status  string         `json:"status"`
 	Error   string         `json:"error"`
@@ -110,3 +139,9 @@ type PurgeResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+type SignTxResponse struct {
+	Status   string                 `json:"status"`
+	Error    string                 `json:"error"`
+	SignedTx *txs.SignedTransaction `json:"signedTx"`
+}
@@ -29,6 +29,8 @@ type RewardsMode string
 type MevRelayID string
 type MevSelectionMode string
 type NimbusPruningMode string
+type AutoSweepMode string
+type NodeSignerType string
 
 // Enum to describe which container(s) a parameter impacts, so the Stadernode knows which
 // ones to restart upon a settings change
@@ -127,6 +129,22 @@ const (
 	NimbusPruningMode_Prune   NimbusPruningMode = "prune"
 )
 
+// Enum to describe what the node daemon does when rewards are due to be swept
+const (
+	AutoSweepMode_Disabled AutoSweepMode = "disabled"
+	AutoSweepMode_Notify   AutoSweepMode = "notify"
+	AutoSweepMode_DryRun   AutoSweepMode = "dry-run"
+	AutoSweepMode_Enabled  AutoSweepMode = "enabled"
+)
+
+// Enum to describe what signs for the node account
+const (
+	NodeSignerType_Local   NodeSignerType = "local"
+	NodeSignerType_Clef    NodeSignerType = "clef"
+	NodeSignerType_JsonRpc NodeSignerType = "jsonrpc"
+	NodeSignerType_Offline NodeSignerType = "offline"
+)
+
 type Config interface {
 	GetConfigTitle() string
 	GetParameters() []*Parameter
@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/stader-labs/stader-node/shared/services/txs"
+)
+
+// Save an unsigned transaction built by the node to a file, so it can be signed on an offline machine
+func SaveUnsignedTx(path string, unsignedTx *txs.UnsignedTransaction) error {
+
+	bytes, err := json.MarshalIndent(unsignedTx, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode unsigned transaction: %w", err)
+	}
+	if err := os.WriteFile(path, bytes, 0644); err != nil {
+		return fmt.Errorf("could not save unsigned transaction to %s: %w", path, err)
+	}
+
+	fmt.Printf("The unsigned transaction was saved to %s%s%s.\n\n", colorLightBlue, path, colorReset)
+	fmt.Println("To send it:")
+	fmt.Printf("1. Copy it to the offline machine and sign it with `stader-cli wallet sign-tx %s`.\n", path)
+	fmt.Println("2. Copy the signed transaction back to this machine and send it with `stader-cli node broadcast <signed transaction file>`.")
+	fmt.Printf("\n%sThe transaction uses nonce %d. If the node account sends another transaction with this nonce before it is broadcast, it can no longer be sent and must be built again.%s\n", colorYellow, uint64(unsignedTx.Nonce), colorReset)
+	return nil
+
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/txs"
 	"github.com/urfave/cli"
 )
 
@@ -78,6 +79,30 @@ func CheckForNonceOverride(c *cli.Context, opts *bind.TransactOpts) error {
 
 }
 
+// Sets the provided transaction options to build the transaction without signing or sending it if requested,
+// so it can be signed on an offline machine
+func CheckForUnsignedTx(c *cli.Context, opts *bind.TransactOpts) {
+	if !c.GlobalBool("unsigned-tx") {
+		return
+	}
+	from := opts.From
+	opts.NoSend = true
+	opts.Signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if address != from {
+			return nil, bind.ErrNotAuthorized
+		}
+		return tx, nil
+	}
+}
+
+// Gets the unsigned transaction built with the provided transaction options, or nil if they sent it
+func GetUnsignedTx(c *cli.Context, opts *bind.TransactOpts, tx *types.Transaction) (*txs.UnsignedTransaction, error) {
+	if !opts.NoSend {
+		return nil, nil
+	}
+	return txs.NewUnsignedTransaction(tx, opts.From, c.Command.FullName())
+}
+
 func GetCurrentBlockNumber(c *cli.Context) (uint64, error) {
 	ec, err := services.GetEthClient(c)
 	if err != nil {
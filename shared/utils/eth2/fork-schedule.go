@@ -0,0 +1,156 @@
+package eth2
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/ethereum/go-ethereum/common"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/types/config"
+)
+
+// Since Deneb, voluntary exits are always signed with the Capella fork version (EIP-7044)
+const (
+	voluntaryExitForkName      = "capella"
+	voluntaryExitFixedForkName = "deneb"
+)
+
+// The genesis data and forks of a network
+type ForkSchedule struct {
+	GenesisValidatorsRoot []byte
+	GenesisForkVersion    []byte
+	Forks                 []beacon.Fork
+}
+
+// Fork schedules of the networks with a fixed genesis, used to sign exits without trusting the Beacon node's fork
+var forkSchedules = map[config.Network]ForkSchedule{
+	config.Network_Mainnet: {
+		GenesisValidatorsRoot: common.FromHex("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"),
+		GenesisForkVersion:    common.FromHex("0x00000000"),
+		Forks: []beacon.Fork{
+			{Name: "altair", Version: common.FromHex("0x01000000"), Epoch: 74240},
+			{Name: "bellatrix", Version: common.FromHex("0x02000000"), Epoch: 144896},
+			{Name: "capella", Version: common.FromHex("0x03000000"), Epoch: 194048},
+			{Name: "deneb", Version: common.FromHex("0x04000000"), Epoch: 269568},
+		},
+	},
+	config.Network_Prater: {
+		GenesisValidatorsRoot: common.FromHex("0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb"),
+		GenesisForkVersion:    common.FromHex("0x00001020"),
+		Forks: []beacon.Fork{
+			{Name: "altair", Version: common.FromHex("0x01001020"), Epoch: 36660},
+			{Name: "bellatrix", Version: common.FromHex("0x02001020"), Epoch: 112260},
+			{Name: "capella", Version: common.FromHex("0x03001020"), Epoch: 162304},
+			{Name: "deneb", Version: common.FromHex("0x04001020"), Epoch: 231680},
+		},
+	},
+}
+
+// Get the fork schedule of a network, checked against the Beacon node's genesis and spec.
+// Networks without a built-in schedule, like devnets, use the Beacon node's unchecked, with a warning.
+func GetCheckedForkSchedule(network config.Network, eth2Config beacon.Eth2Config) (ForkSchedule, error) {
+	schedule, exists := forkSchedules[network]
+	if !exists {
+		log.Printf("WARNING: The %s network has no built-in fork schedule, exits will be signed with the Beacon node's genesis validators root 0x%x and forks without checking them.\n", network, eth2Config.GenesisValidatorsRoot)
+		return ForkSchedule{
+			GenesisValidatorsRoot: eth2Config.GenesisValidatorsRoot,
+			GenesisForkVersion:    eth2Config.GenesisForkVersion,
+			Forks:                 eth2Config.Forks,
+		}, nil
+	}
+
+	if !bytes.Equal(schedule.GenesisValidatorsRoot, eth2Config.GenesisValidatorsRoot) {
+		return ForkSchedule{}, fmt.Errorf("the Beacon node's genesis validators root 0x%x does not match the %s network's 0x%x, make sure it is running on the right network", eth2Config.GenesisValidatorsRoot, network, schedule.GenesisValidatorsRoot)
+	}
+	if !bytes.Equal(schedule.GenesisForkVersion, eth2Config.GenesisForkVersion) {
+		return ForkSchedule{}, fmt.Errorf("the Beacon node's genesis fork version 0x%x does not match the %s network's 0x%x", eth2Config.GenesisForkVersion, network, schedule.GenesisForkVersion)
+	}
+	for _, bnFork := range eth2Config.Forks {
+		for _, fork := range schedule.Forks {
+			if fork.Name != bnFork.Name {
+				continue
+			}
+			if !bytes.Equal(fork.Version, bnFork.Version) || fork.Epoch != bnFork.Epoch {
+				return ForkSchedule{}, fmt.Errorf("the Beacon node schedules the %s fork with version 0x%x at epoch %d, but the %s network has version 0x%x at epoch %d", fork.Name, bnFork.Version, bnFork.Epoch, network, fork.Version, fork.Epoch)
+			}
+		}
+	}
+
+	return schedule, nil
+}
+
+// Get the genesis validators root of a network with a built-in schedule
+func GetGenesisValidatorsRoot(network config.Network) ([]byte, bool) {
+	schedule, exists := forkSchedules[network]
+	return schedule.GenesisValidatorsRoot, exists
+}
+
+// Get a fork by name
+func (s ForkSchedule) GetFork(name string) (beacon.Fork, bool) {
+	for _, fork := range s.Forks {
+		if fork.Name == name {
+			return fork, true
+		}
+	}
+	return beacon.Fork{}, false
+}
+
+// Get the name of the fork a version belongs to
+func (s ForkSchedule) ForkName(version []byte) string {
+	if bytes.Equal(version, s.GenesisForkVersion) {
+		return "genesis"
+	}
+	for _, fork := range s.Forks {
+		if bytes.Equal(version, fork.Version) {
+			return fork.Name
+		}
+	}
+	return fmt.Sprintf("unknown (0x%x)", version)
+}
+
+// Get the fork version active at an epoch
+func (s ForkSchedule) ForkVersionAt(epoch uint64) []byte {
+	version := s.GenesisForkVersion
+	for _, fork := range s.Forks {
+		if fork.Epoch <= epoch {
+			version = fork.Version
+		}
+	}
+	return version
+}
+
+// Get the fork version voluntary exits for an epoch are signed with.
+// Once Capella is reached the Capella version is used, so the exit stays valid after later forks.
+func (s ForkSchedule) VoluntaryExitForkVersion(epoch uint64) []byte {
+	for _, fork := range s.Forks {
+		if fork.Name == voluntaryExitForkName && fork.Epoch <= epoch {
+			return fork.Version
+		}
+	}
+	return s.ForkVersionAt(epoch)
+}
+
+// Get the fork version the Beacon chain accepts a voluntary exit for an epoch with, at the current epoch.
+// Once Deneb is active only the Capella version is accepted, whatever the exit epoch.
+func (s ForkSchedule) AcceptedVoluntaryExitForkVersion(exitEpoch uint64, currentEpoch uint64) []byte {
+	fixedFork, exists := s.GetFork(voluntaryExitFixedForkName)
+	if exists && fixedFork.Epoch <= currentEpoch {
+		if exitFork, exists := s.GetFork(voluntaryExitForkName); exists {
+			return exitFork.Version
+		}
+	}
+	return s.VoluntaryExitForkVersion(exitEpoch)
+}
+
+// Get the signature domain of a voluntary exit for an epoch
+func (s ForkSchedule) VoluntaryExitDomain(epoch uint64) []byte {
+	return s.VoluntaryExitDomainForVersion(s.VoluntaryExitForkVersion(epoch))
+}
+
+// Get the signature domain of a voluntary exit signed with a fork version
+func (s ForkSchedule) VoluntaryExitDomainForVersion(version []byte) []byte {
+	return eth2types.Domain(eth2types.DomainVoluntaryExit, version, s.GenesisValidatorsRoot)
+}
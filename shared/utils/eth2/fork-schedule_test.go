@@ -0,0 +1,119 @@
+package eth2
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/types/config"
+	"github.com/stader-labs/stader-node/shared/utils/validator"
+)
+
+// compute_domain(DOMAIN_VOLUNTARY_EXIT, <Capella fork version>, <genesis validators root>) of the public networks,
+// the domain every exit is signed with since EIP-7044
+var capellaExitDomains = map[config.Network]string{
+	config.Network_Mainnet: "0x04000000bba4da96354c9f25476cf1bc69bf583a7f9e0af049305b62de676640",
+	config.Network_Prater:  "0x04000000628941ef21d1fe8c7134720add10bb91e3b02c007e0046d2472c6695",
+}
+
+// The exit the signing root vectors are computed for
+const (
+	vectorExitEpoch          = 194048
+	vectorExitValidatorIndex = 1
+)
+
+// compute_signing_root(VoluntaryExit(epoch=194048, validator_index=1), <Capella exit domain>)
+var capellaExitSigningRoots = map[config.Network]string{
+	config.Network_Mainnet: "0xdbb8c86dd597aafdde69a4bc879a7eb5c5124701bf0fdb0dc6e97c7e229547c0",
+	config.Network_Prater:  "0x0ac24313f8b9d9caaca95db089f21273b63d18cb750b28c175b631cf7fce769f",
+}
+
+func TestVoluntaryExitDomain(t *testing.T) {
+	for network, expected := range capellaExitDomains {
+		schedule := forkSchedules[network]
+		capella, _ := schedule.GetFork("capella")
+		deneb, _ := schedule.GetFork("deneb")
+
+		// Exits for Capella and every later fork share the Capella domain
+		for _, epoch := range []uint64{capella.Epoch, deneb.Epoch, deneb.Epoch + 100000} {
+			domain := schedule.VoluntaryExitDomain(epoch)
+			if !bytes.Equal(domain, common.FromHex(expected)) {
+				t.Errorf("%s: exit domain at epoch %d is 0x%x, expected %s", network, epoch, domain, expected)
+			}
+		}
+
+		// Exits for earlier epochs are signed with the fork version active then
+		bellatrix, _ := schedule.GetFork("bellatrix")
+		version := schedule.VoluntaryExitForkVersion(capella.Epoch - 1)
+		if !bytes.Equal(version, bellatrix.Version) {
+			t.Errorf("%s: exit fork version before Capella is 0x%x, expected 0x%x", network, version, bellatrix.Version)
+		}
+
+		// Once Deneb is active, only the Capella version is accepted
+		version = schedule.AcceptedVoluntaryExitForkVersion(capella.Epoch-1, deneb.Epoch)
+		if !bytes.Equal(version, capella.Version) {
+			t.Errorf("%s: accepted exit fork version after Deneb is 0x%x, expected 0x%x", network, version, capella.Version)
+		}
+		version = schedule.AcceptedVoluntaryExitForkVersion(capella.Epoch-1, deneb.Epoch-1)
+		if !bytes.Equal(version, bellatrix.Version) {
+			t.Errorf("%s: accepted exit fork version before Deneb is 0x%x, expected 0x%x", network, version, bellatrix.Version)
+		}
+	}
+}
+
+func TestExitSigningRoot(t *testing.T) {
+	for network, expected := range capellaExitSigningRoots {
+		schedule := forkSchedules[network]
+		signingRoot, err := validator.GetExitMessageSigningRoot(vectorExitValidatorIndex, vectorExitEpoch, schedule.VoluntaryExitDomain(vectorExitEpoch))
+		if err != nil {
+			t.Fatalf("%s: error getting the exit signing root: %s", network, err)
+		}
+		if !bytes.Equal(signingRoot[:], common.FromHex(expected)) {
+			t.Errorf("%s: exit signing root is 0x%x, expected %s", network, signingRoot, expected)
+		}
+	}
+}
+
+func TestGetCheckedForkSchedule(t *testing.T) {
+	mainnet := forkSchedules[config.Network_Mainnet]
+	eth2Config := beacon.Eth2Config{
+		GenesisValidatorsRoot: mainnet.GenesisValidatorsRoot,
+		GenesisForkVersion:    mainnet.GenesisForkVersion,
+		Forks:                 mainnet.Forks,
+	}
+
+	// A matching Beacon node
+	if _, err := GetCheckedForkSchedule(config.Network_Mainnet, eth2Config); err != nil {
+		t.Errorf("unexpected error for a matching Beacon node: %s", err)
+	}
+
+	// A Beacon node on another network
+	if _, err := GetCheckedForkSchedule(config.Network_Prater, eth2Config); err == nil {
+		t.Error("expected an error for a Beacon node on another network")
+	}
+
+	// A Beacon node with a different Capella epoch
+	forks := make([]beacon.Fork, len(mainnet.Forks))
+	copy(forks, mainnet.Forks)
+	for i := range forks {
+		if forks[i].Name == "capella" {
+			forks[i].Epoch++
+		}
+	}
+	eth2Config.Forks = forks
+	if _, err := GetCheckedForkSchedule(config.Network_Mainnet, eth2Config); err == nil {
+		t.Error("expected an error for a Beacon node with a different Capella epoch")
+	}
+
+	// Networks without a built-in schedule use the Beacon node's
+	schedule, err := GetCheckedForkSchedule(config.Network_Devnet, eth2Config)
+	if err != nil {
+		t.Fatalf("unexpected error for a network without a built-in schedule: %s", err)
+	}
+	capella, _ := schedule.GetFork("capella")
+	if capella.Epoch != forks[2].Epoch {
+		t.Errorf("the Beacon node's Capella epoch %d was not used, got %d", forks[2].Epoch, capella.Epoch)
+	}
+}
@@ -0,0 +1,292 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/utils/log"
+)
+
+// Config
+const (
+	DefaultDrainTimeout = 2 * time.Minute
+
+	// How long cancelled runs get to return before the scheduler stops waiting for them
+	DefaultCancelTimeout = 15 * time.Second
+)
+
+// A unit of work run periodically by the scheduler.
+// The context is cancelled when the run timeout expires or when the scheduler gives up draining on shutdown.
+type TaskFunc func(ctx context.Context) error
+
+// A task to register with the scheduler
+type Task struct {
+	// Unique name of the task
+	Name string
+
+	// Time to wait between the end of a run and the start of the next one
+	Interval time.Duration
+
+	// Time to wait after a failed run, defaults to Interval
+	RetryInterval time.Duration
+
+	// Maximum random delay added to every wait, so tasks don't hit the clients in lockstep
+	Jitter time.Duration
+
+	// Maximum duration of a single run, no limit if zero
+	Timeout time.Duration
+
+	// The work to run
+	Run TaskFunc
+}
+
+// The last known state of a task
+type TaskStatus struct {
+	Name         string        `json:"name"`
+	Running      bool          `json:"running"`
+	Runs         uint64        `json:"runs"`
+	LastRun      time.Time     `json:"lastRun"`
+	LastDuration time.Duration `json:"lastDuration"`
+	LastSuccess  time.Time     `json:"lastSuccess"`
+	LastError    string        `json:"lastError"`
+	LastErrorAt  time.Time     `json:"lastErrorAt"`
+}
+
+type scheduledTask struct {
+	task   Task
+	status TaskStatus
+}
+
+// Runs a set of periodic tasks until its context is cancelled, then drains the runs in flight
+type Scheduler struct {
+	tasks         []*scheduledTask
+	drainTimeout  time.Duration
+	cancelTimeout time.Duration
+	log           *log.ColorLogger
+	lock          sync.Mutex
+	started       bool
+}
+
+// Create a new scheduler
+func NewScheduler(logger *log.ColorLogger) *Scheduler {
+	return &Scheduler{
+		tasks:         []*scheduledTask{},
+		drainTimeout:  DefaultDrainTimeout,
+		cancelTimeout: DefaultCancelTimeout,
+		log:           logger,
+	}
+}
+
+// Set how long in-flight runs may keep going after shutdown is requested before their context is cancelled
+func (s *Scheduler) SetDrainTimeout(drainTimeout time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.drainTimeout = drainTimeout
+}
+
+// Set how long cancelled runs may take to return before the scheduler stops waiting for them
+func (s *Scheduler) SetCancelTimeout(cancelTimeout time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cancelTimeout = cancelTimeout
+}
+
+// Register a task. Tasks must be added before the scheduler is started.
+func (s *Scheduler) AddTask(task Task) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.started {
+		return fmt.Errorf("cannot add task %s, the scheduler is already running", task.Name)
+	}
+	if task.Name == "" {
+		return fmt.Errorf("task name cannot be empty")
+	}
+	if task.Run == nil {
+		return fmt.Errorf("task %s has nothing to run", task.Name)
+	}
+	if task.Interval <= 0 {
+		return fmt.Errorf("task %s must have a positive interval", task.Name)
+	}
+	for _, existing := range s.tasks {
+		if existing.task.Name == task.Name {
+			return fmt.Errorf("task %s is already registered", task.Name)
+		}
+	}
+	if task.RetryInterval <= 0 {
+		task.RetryInterval = task.Interval
+	}
+
+	s.tasks = append(s.tasks, &scheduledTask{
+		task:   task,
+		status: TaskStatus{Name: task.Name},
+	})
+	return nil
+}
+
+// Get the status of every registered task, in registration order
+func (s *Scheduler) GetTaskStatuses() []TaskStatus {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	statuses := make([]TaskStatus, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		statuses = append(statuses, t.status)
+	}
+	return statuses
+}
+
+// Get the status of a single task
+func (s *Scheduler) GetTaskStatus(name string) (TaskStatus, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, t := range s.tasks {
+		if t.task.Name == name {
+			return t.status, true
+		}
+	}
+	return TaskStatus{}, false
+}
+
+// Run all tasks until the context is cancelled.
+// No new runs are started after that; runs in flight get up to the drain timeout to finish before their context is cancelled,
+// and up to the cancel timeout after that to return. Runs that ignore their context are abandoned so shutdown can't hang.
+func (s *Scheduler) Run(ctx context.Context) error {
+	s.lock.Lock()
+	if s.started {
+		s.lock.Unlock()
+		return fmt.Errorf("the scheduler is already running")
+	}
+	s.started = true
+	tasks := s.tasks
+	drainTimeout := s.drainTimeout
+	cancelTimeout := s.cancelTimeout
+	s.lock.Unlock()
+
+	// Runs get their own context so a shutdown request doesn't cut them off immediately
+	runCtx, cancelRuns := context.WithCancel(context.Background())
+	defer cancelRuns()
+
+	wg := new(sync.WaitGroup)
+	wg.Add(len(tasks))
+	for _, t := range tasks {
+		go func(t *scheduledTask) {
+			defer wg.Done()
+			s.loop(ctx, runCtx, t)
+		}(t)
+	}
+
+	<-ctx.Done()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	s.logf("Shutdown requested, waiting up to %s for running tasks to finish", drainTimeout)
+	select {
+	case <-done:
+	case <-time.After(drainTimeout):
+		s.logf("Running tasks did not finish in time, cancelling them")
+		cancelRuns()
+		select {
+		case <-done:
+		case <-time.After(cancelTimeout):
+			s.logf("Tasks %v did not stop after being cancelled, abandoning them", s.getRunningTaskNames())
+			return nil
+		}
+	}
+	s.logf("All tasks stopped")
+
+	return nil
+}
+
+// Run a task repeatedly until shutdown is requested
+func (s *Scheduler) loop(ctx context.Context, runCtx context.Context, t *scheduledTask) {
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		wait := t.task.Interval
+		if err := s.execute(runCtx, t); err != nil {
+			wait = t.task.RetryInterval
+		}
+		if t.task.Jitter > 0 {
+			wait += time.Duration(rand.Int63n(int64(t.task.Jitter)))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// Run a task once and record the outcome
+func (s *Scheduler) execute(runCtx context.Context, t *scheduledTask) (err error) {
+	taskCtx := runCtx
+	if t.task.Timeout > 0 {
+		var cancel context.CancelFunc
+		taskCtx, cancel = context.WithTimeout(runCtx, t.task.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	s.lock.Lock()
+	t.status.Running = true
+	t.status.LastRun = start
+	s.lock.Unlock()
+
+	defer func() {
+		// A panicking task must not take the whole daemon down
+		if r := recover(); r != nil {
+			err = fmt.Errorf("task panicked: %v", r)
+		}
+
+		s.lock.Lock()
+		t.status.Running = false
+		t.status.Runs++
+		t.status.LastDuration = time.Since(start)
+		if err != nil {
+			t.status.LastError = err.Error()
+			t.status.LastErrorAt = time.Now()
+		} else {
+			t.status.LastSuccess = time.Now()
+		}
+		s.lock.Unlock()
+
+		if err != nil {
+			s.logf("Task %s failed: %s", t.task.Name, err.Error())
+		}
+	}()
+
+	return t.task.Run(taskCtx)
+}
+
+// Get the names of the tasks with a run in progress
+func (s *Scheduler) getRunningTaskNames() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	names := []string{}
+	for _, t := range s.tasks {
+		if t.status.Running {
+			names = append(names, t.task.Name)
+		}
+	}
+	return names
+}
+
+// Logs a line if the logger is specified
+func (s *Scheduler) logf(format string, v ...interface{}) {
+	if s.log != nil {
+		s.log.Printlnf(format, v...)
+	}
+}
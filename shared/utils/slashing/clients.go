@@ -0,0 +1,122 @@
+package slashing
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/stader-labs/stader-node/shared/types/config"
+)
+
+// Paths in the tool container.
+// Exports are written inside the container and copied out, so the file belongs to the user instead of the container's root user.
+const (
+	ValidatorsMountPath  = "/validators"
+	InterchangeMountPath = "/interchange"
+	InterchangeFilename  = "slashing_protection.json"
+	exportDir            = "/tmp"
+)
+
+// Path of the exported interchange file in the tool container
+var ExportFilePath = filepath.Join(exportDir, InterchangeFilename)
+
+// A validator client command that exports or imports its slashing protection history, run in a container of the client's image
+type ToolCommand struct {
+	Entrypoint string
+	Args       []string
+}
+
+// Get the command that exports a validator client's slashing protection history to the interchange file
+func GetExportCommand(client config.ConsensusClient, network config.Network) (ToolCommand, error) {
+	file := ExportFilePath
+	switch client {
+	case config.ConsensusClient_Lighthouse:
+		networkArg, err := getLighthouseNetworkArg(network)
+		if err != nil {
+			return ToolCommand{}, err
+		}
+		return ToolCommand{
+			Entrypoint: "/usr/local/bin/lighthouse",
+			Args:       []string{"account", "validator", "slashing-protection", "export", file, networkArg, "--datadir", ValidatorsMountPath + "/lighthouse"},
+		}, nil
+	case config.ConsensusClient_Nimbus:
+		// The slashing protection database is kept in the validators dir under the data dir, which the validator client sets directly
+		return ToolCommand{
+			Entrypoint: "/home/user/nimbus-eth2/build/nimbus_beacon_node",
+			Args:       []string{"--data-dir=" + ValidatorsMountPath + "/nimbus", "slashingdb", "export", file},
+		}, nil
+	case config.ConsensusClient_Prysm:
+		// Prysm always names the file it exports slashing_protection.json
+		return ToolCommand{
+			Entrypoint: "/app/cmd/validator/validator",
+			Args:       []string{"slashing-protection-history", "export", "--accept-terms-of-use", "--datadir=" + ValidatorsMountPath + "/prysm-non-hd/direct", "--slashing-protection-export-dir=" + exportDir},
+		}, nil
+	case config.ConsensusClient_Teku:
+		return ToolCommand{
+			Entrypoint: "/opt/teku/bin/teku",
+			Args:       []string{"slashing-protection", "export", "--data-path=" + ValidatorsMountPath + "/teku", "--to=" + file},
+		}, nil
+	default:
+		return ToolCommand{}, fmt.Errorf("exporting slashing protection data is not supported for validator client [%v]", client)
+	}
+}
+
+// Get the command that imports the interchange file into a validator client's slashing protection history
+func GetImportCommand(client config.ConsensusClient, network config.Network) (ToolCommand, error) {
+	file := filepath.Join(InterchangeMountPath, InterchangeFilename)
+	switch client {
+	case config.ConsensusClient_Lighthouse:
+		networkArg, err := getLighthouseNetworkArg(network)
+		if err != nil {
+			return ToolCommand{}, err
+		}
+		return ToolCommand{
+			Entrypoint: "/usr/local/bin/lighthouse",
+			Args:       []string{"account", "validator", "slashing-protection", "import", file, networkArg, "--datadir", ValidatorsMountPath + "/lighthouse"},
+		}, nil
+	case config.ConsensusClient_Nimbus:
+		return ToolCommand{
+			Entrypoint: "/home/user/nimbus-eth2/build/nimbus_beacon_node",
+			Args:       []string{"--data-dir=" + ValidatorsMountPath + "/nimbus", "slashingdb", "import", file},
+		}, nil
+	case config.ConsensusClient_Prysm:
+		return ToolCommand{
+			Entrypoint: "/app/cmd/validator/validator",
+			Args:       []string{"slashing-protection-history", "import", "--accept-terms-of-use", "--datadir=" + ValidatorsMountPath + "/prysm-non-hd/direct", "--slashing-protection-json-file=" + file},
+		}, nil
+	case config.ConsensusClient_Teku:
+		return ToolCommand{
+			Entrypoint: "/opt/teku/bin/teku",
+			Args:       []string{"slashing-protection", "import", "--data-path=" + ValidatorsMountPath + "/teku", "--from=" + file},
+		}, nil
+	default:
+		return ToolCommand{}, fmt.Errorf("importing slashing protection data is not supported for validator client [%v]", client)
+	}
+}
+
+// Get the validator client a Docker image belongs to
+func GetClientFromImage(image string) (config.ConsensusClient, error) {
+	for _, client := range []config.ConsensusClient{
+		config.ConsensusClient_Lighthouse,
+		config.ConsensusClient_Nimbus,
+		config.ConsensusClient_Prysm,
+		config.ConsensusClient_Teku,
+	} {
+		if strings.Contains(image, string(client)) {
+			return client, nil
+		}
+	}
+	return config.ConsensusClient_Unknown, fmt.Errorf("can't tell which validator client the image [%s] belongs to", image)
+}
+
+// Lighthouse checks the genesis validators root of the interchange against its network
+func getLighthouseNetworkArg(network config.Network) (string, error) {
+	switch network {
+	case config.Network_Mainnet:
+		return "--network=mainnet", nil
+	case config.Network_Prater, config.Network_Devnet:
+		return "--network=prater", nil
+	default:
+		return "", fmt.Errorf("Lighthouse slashing protection data can't be moved on the %s network", network)
+	}
+}
@@ -0,0 +1,244 @@
+package slashing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	hexutil "github.com/stader-labs/stader-node/shared/utils/hex"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Config
+const (
+	InterchangeFormatVersion = "5"
+	InterchangeFileMode      = 0644
+	signingRootLength        = 32
+)
+
+// Slashing protection history in the EIP-3076 interchange format (https://eips.ethereum.org/EIPS/eip-3076)
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []ValidatorHistory  `json:"data"`
+}
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+}
+type ValidatorHistory struct {
+	Pubkey             string              `json:"pubkey"`
+	SignedBlocks       []SignedBlock       `json:"signed_blocks"`
+	SignedAttestations []SignedAttestation `json:"signed_attestations"`
+}
+type SignedBlock struct {
+	Slot        Uint64String `json:"slot"`
+	SigningRoot string       `json:"signing_root,omitempty"`
+}
+type SignedAttestation struct {
+	SourceEpoch Uint64String `json:"source_epoch"`
+	TargetEpoch Uint64String `json:"target_epoch"`
+	SigningRoot string       `json:"signing_root,omitempty"`
+}
+
+// A uint64 encoded as a decimal string, as the interchange format requires
+type Uint64String uint64
+
+func (u Uint64String) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(u), 10))
+}
+func (u *Uint64String) UnmarshalJSON(data []byte) error {
+	var dataStr string
+	if err := json.Unmarshal(data, &dataStr); err != nil {
+		return err
+	}
+	value, err := strconv.ParseUint(dataStr, 10, 64)
+	if err != nil {
+		return fmt.Errorf("Invalid uint64 string '%s': %w", dataStr, err)
+	}
+	*u = Uint64String(value)
+	return nil
+}
+
+// Load an interchange file
+func LoadInterchange(path string) (*Interchange, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading slashing protection file %s: %w", path, err)
+	}
+	interchange := new(Interchange)
+	if err := json.Unmarshal(bytes, interchange); err != nil {
+		return nil, fmt.Errorf("error decoding slashing protection file %s: %w", path, err)
+	}
+	return interchange, nil
+}
+
+// Save the interchange to a file
+func (i *Interchange) Save(path string) error {
+	bytes, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding slashing protection data: %w", err)
+	}
+	if err := ioutil.WriteFile(path, bytes, InterchangeFileMode); err != nil {
+		return fmt.Errorf("error writing slashing protection file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Check the interchange is well formed and, if genesisValidatorsRoot is set, that it belongs to that network
+func (i *Interchange) Validate(genesisValidatorsRoot []byte) error {
+
+	// Check the metadata
+	if i.Metadata.InterchangeFormatVersion != InterchangeFormatVersion {
+		return fmt.Errorf("unsupported interchange format version '%s', only version %s is supported", i.Metadata.InterchangeFormatVersion, InterchangeFormatVersion)
+	}
+	root, err := decodeHex(i.Metadata.GenesisValidatorsRoot, signingRootLength)
+	if err != nil {
+		return fmt.Errorf("invalid genesis validators root: %w", err)
+	}
+	if genesisValidatorsRoot != nil && !bytes.Equal(root, genesisValidatorsRoot) {
+		return fmt.Errorf("genesis validators root %s does not match this network's 0x%x; the file is for another network", i.Metadata.GenesisValidatorsRoot, genesisValidatorsRoot)
+	}
+
+	// Check the validator histories
+	for _, history := range i.Data {
+		if _, err := decodeHex(history.Pubkey, types.ValidatorPubkeyLength); err != nil {
+			return fmt.Errorf("invalid validator pubkey '%s': %w", history.Pubkey, err)
+		}
+		for _, block := range history.SignedBlocks {
+			if block.SigningRoot != "" {
+				if _, err := decodeHex(block.SigningRoot, signingRootLength); err != nil {
+					return fmt.Errorf("invalid signing root of validator %s block at slot %d: %w", history.Pubkey, block.Slot, err)
+				}
+			}
+		}
+		for _, attestation := range history.SignedAttestations {
+			if attestation.SourceEpoch > attestation.TargetEpoch {
+				return fmt.Errorf("validator %s has an attestation with source epoch %d after its target epoch %d", history.Pubkey, attestation.SourceEpoch, attestation.TargetEpoch)
+			}
+			if attestation.SigningRoot != "" {
+				if _, err := decodeHex(attestation.SigningRoot, signingRootLength); err != nil {
+					return fmt.Errorf("invalid signing root of validator %s attestation with target epoch %d: %w", history.Pubkey, attestation.TargetEpoch, err)
+				}
+			}
+		}
+	}
+
+	return nil
+
+}
+
+// Get the pubkeys of the validators with a history in the interchange
+func (i *Interchange) GetPubkeys() ([]types.ValidatorPubkey, error) {
+	pubkeys := []types.ValidatorPubkey{}
+	seen := map[string]bool{}
+	for _, history := range i.Data {
+		pubkey, err := types.HexToValidatorPubkey(normalizeHex(history.Pubkey)[2:])
+		if err != nil {
+			return nil, err
+		}
+		if !seen[pubkey.Hex()] {
+			seen[pubkey.Hex()] = true
+			pubkeys = append(pubkeys, pubkey)
+		}
+	}
+	return pubkeys, nil
+}
+
+// Merge interchanges of the same network into one, keeping every signed block and attestation once.
+// Clients import the merged history as a whole, so conflicting entries are kept and the clients refuse to sign anything at or below them.
+func MergeInterchanges(interchanges ...*Interchange) (*Interchange, error) {
+
+	if len(interchanges) == 0 {
+		return nil, fmt.Errorf("no slashing protection data to merge")
+	}
+
+	// Merge the histories by validator
+	root := normalizeHex(interchanges[0].Metadata.GenesisValidatorsRoot)
+	histories := map[string]*ValidatorHistory{}
+	pubkeys := []string{}
+	blocksSeen := map[string]bool{}
+	attestationsSeen := map[string]bool{}
+	for _, interchange := range interchanges {
+		if normalizeHex(interchange.Metadata.GenesisValidatorsRoot) != root {
+			return nil, fmt.Errorf("can't merge slashing protection data of different networks (genesis validators roots %s and %s)", root, interchange.Metadata.GenesisValidatorsRoot)
+		}
+		for _, history := range interchange.Data {
+			pubkey := normalizeHex(history.Pubkey)
+			merged, exists := histories[pubkey]
+			if !exists {
+				merged = &ValidatorHistory{
+					Pubkey:             pubkey,
+					SignedBlocks:       []SignedBlock{},
+					SignedAttestations: []SignedAttestation{},
+				}
+				histories[pubkey] = merged
+				pubkeys = append(pubkeys, pubkey)
+			}
+			for _, block := range history.SignedBlocks {
+				block.SigningRoot = normalizeHex(block.SigningRoot)
+				key := fmt.Sprintf("%s/%d/%s", pubkey, block.Slot, block.SigningRoot)
+				if !blocksSeen[key] {
+					blocksSeen[key] = true
+					merged.SignedBlocks = append(merged.SignedBlocks, block)
+				}
+			}
+			for _, attestation := range history.SignedAttestations {
+				attestation.SigningRoot = normalizeHex(attestation.SigningRoot)
+				key := fmt.Sprintf("%s/%d/%d/%s", pubkey, attestation.SourceEpoch, attestation.TargetEpoch, attestation.SigningRoot)
+				if !attestationsSeen[key] {
+					attestationsSeen[key] = true
+					merged.SignedAttestations = append(merged.SignedAttestations, attestation)
+				}
+			}
+		}
+	}
+
+	// Build the merged interchange
+	merged := &Interchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			GenesisValidatorsRoot:    root,
+		},
+		Data: make([]ValidatorHistory, 0, len(pubkeys)),
+	}
+	for _, pubkey := range pubkeys {
+		history := histories[pubkey]
+		sort.SliceStable(history.SignedBlocks, func(i, j int) bool {
+			return history.SignedBlocks[i].Slot < history.SignedBlocks[j].Slot
+		})
+		sort.SliceStable(history.SignedAttestations, func(i, j int) bool {
+			return history.SignedAttestations[i].TargetEpoch < history.SignedAttestations[j].TargetEpoch
+		})
+		merged.Data = append(merged.Data, *history)
+	}
+	return merged, nil
+
+}
+
+// Decode a 0x-prefixed hex string of a given length
+func decodeHex(value string, length int) ([]byte, error) {
+	if !strings.HasPrefix(value, "0x") {
+		return nil, fmt.Errorf("'%s' is not 0x-prefixed", value)
+	}
+	decoded, err := hex.DecodeString(hexutil.RemovePrefix(value))
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != length {
+		return nil, fmt.Errorf("'%s' is %d bytes long instead of %d", value, len(decoded), length)
+	}
+	return decoded, nil
+}
+
+// Lowercase a hex string and make sure it's 0x-prefixed, so the same values compare equal
+func normalizeHex(value string) string {
+	if value == "" {
+		return ""
+	}
+	return hexutil.AddPrefix(strings.ToLower(hexutil.RemovePrefix(value)))
+}
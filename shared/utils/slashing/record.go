@@ -0,0 +1,151 @@
+package slashing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/types/config"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Config
+const (
+	NoHistoryFilename = "no-history.json"
+)
+
+// A copy of the slashing protection data last imported into a validator client, used to check which validators it protects
+type ImportRecord struct {
+	Interchange *Interchange
+	ImportedAt  time.Time
+}
+
+// Get the path of the import record of a validator client
+func GetImportRecordPath(dir string, client config.ConsensusClient) string {
+	return filepath.Join(dir, fmt.Sprintf("%s.json", client))
+}
+
+// Load the import record of a validator client; returns nil if nothing was imported into it
+func LoadImportRecord(dir string, client config.ConsensusClient) (*ImportRecord, error) {
+	path := GetImportRecordPath(dir, client)
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error checking slashing protection import record %s: %w", path, err)
+	}
+	interchange, err := LoadInterchange(path)
+	if err != nil {
+		return nil, err
+	}
+	return &ImportRecord{
+		Interchange: interchange,
+		ImportedAt:  info.ModTime(),
+	}, nil
+}
+
+// Save the data imported into a validator client as its import record
+func SaveImportRecord(dir string, client config.ConsensusClient, interchange *Interchange) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("error creating slashing protection folder %s: %w", dir, err)
+	}
+	return interchange.Save(GetImportRecordPath(dir, client))
+}
+
+// Get the validators that have no slashing protection data in the record
+func (r *ImportRecord) GetMissingPubkeys(pubkeys []types.ValidatorPubkey) ([]types.ValidatorPubkey, error) {
+	missing := []types.ValidatorPubkey{}
+	protected := map[types.ValidatorPubkey]bool{}
+	if r != nil {
+		recorded, err := r.Interchange.GetPubkeys()
+		if err != nil {
+			return nil, err
+		}
+		for _, pubkey := range recorded {
+			protected[pubkey] = true
+		}
+	}
+	for _, pubkey := range pubkeys {
+		if !protected[pubkey] {
+			missing = append(missing, pubkey)
+		}
+	}
+	return missing, nil
+}
+
+// Load the validators the operator confirmed have never signed anything, so they have no slashing protection history to import
+func LoadNoHistoryPubkeys(dir string) ([]types.ValidatorPubkey, error) {
+	path := filepath.Join(dir, NoHistoryFilename)
+	bytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return []types.ValidatorPubkey{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", path, err)
+	}
+	pubkeys := []types.ValidatorPubkey{}
+	if err := json.Unmarshal(bytes, &pubkeys); err != nil {
+		return nil, fmt.Errorf("error decoding %s: %w", path, err)
+	}
+	return pubkeys, nil
+}
+
+// Record that validators have never signed anything, so they don't need slashing protection history in any validator client
+func AddNoHistoryPubkeys(dir string, pubkeys []types.ValidatorPubkey) error {
+	recorded, err := LoadNoHistoryPubkeys(dir)
+	if err != nil {
+		return err
+	}
+	seen := map[types.ValidatorPubkey]bool{}
+	for _, pubkey := range recorded {
+		seen[pubkey] = true
+	}
+	for _, pubkey := range pubkeys {
+		if !seen[pubkey] {
+			seen[pubkey] = true
+			recorded = append(recorded, pubkey)
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("error creating slashing protection folder %s: %w", dir, err)
+	}
+	bytes, err := json.MarshalIndent(recorded, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding validators without slashing protection history: %w", err)
+	}
+	path := filepath.Join(dir, NoHistoryFilename)
+	if err := ioutil.WriteFile(path, bytes, InterchangeFileMode); err != nil {
+		return fmt.Errorf("error writing %s: %w", path, err)
+	}
+	return nil
+}
+
+// Get the validators a validator client has no slashing protection history for, leaving out those confirmed to have none
+func GetUnprotectedPubkeys(dir string, client config.ConsensusClient, pubkeys []types.ValidatorPubkey) ([]types.ValidatorPubkey, error) {
+	record, err := LoadImportRecord(dir, client)
+	if err != nil {
+		return nil, err
+	}
+	missing, err := record.GetMissingPubkeys(pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	noHistory, err := LoadNoHistoryPubkeys(dir)
+	if err != nil {
+		return nil, err
+	}
+	confirmed := map[types.ValidatorPubkey]bool{}
+	for _, pubkey := range noHistory {
+		confirmed[pubkey] = true
+	}
+	unprotected := []types.ValidatorPubkey{}
+	for _, pubkey := range missing {
+		if !confirmed[pubkey] {
+			unprotected = append(unprotected, pubkey)
+		}
+	}
+	return unprotected, nil
+}
@@ -0,0 +1,284 @@
+package stader
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mitchellh/go-homedir"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
+	socializing_pool "github.com/stader-labs/stader-node/stader-lib/socializing-pool"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+type MerkleProofFailure string
+
+const (
+	// The backend response can't be decoded
+	MerkleProofFailure_Malformed MerkleProofFailure = "malformed"
+	// The proof doesn't fold to the root the backend sent along with it, the backend is at fault
+	MerkleProofFailure_BackendRootMismatch MerkleProofFailure = "backend-root-mismatch"
+	// The cycle has no merkle root on chain yet
+	MerkleProofFailure_NoOnChainRoot MerkleProofFailure = "no-on-chain-root"
+	// The backend root differs from the root submitted on chain
+	MerkleProofFailure_OnChainRootMismatch MerkleProofFailure = "on-chain-root-mismatch"
+	// The proof folds to the on-chain root but the socializing pool still rejects it
+	MerkleProofFailure_OnChainRejected MerkleProofFailure = "on-chain-rejected"
+)
+
+// Returned when a cycle's merkle proof fails verification
+type MerkleProofVerificationError struct {
+	Cycle   int64
+	Failure MerkleProofFailure
+	Reason  string
+}
+
+func (e *MerkleProofVerificationError) Error() string {
+	return fmt.Sprintf("merkle proof for cycle %d failed verification (%s): %s", e.Cycle, e.Failure, e.Reason)
+}
+
+// A merkle proof that failed verification, along with why
+type QuarantinedCycleMerkleProofs struct {
+	Proof         stader_backend.CycleMerkleProofs `json:"proof"`
+	Failure       MerkleProofFailure               `json:"failure"`
+	Reason        string                           `json:"reason"`
+	QuarantinedAt time.Time                        `json:"quarantinedAt"`
+}
+
+// The decoded claim arguments of a cycle's merkle proof
+type ParsedCycleMerkleProofs struct {
+	Root        [32]byte
+	AmountSd    *big.Int
+	AmountEth   *big.Int
+	MerkleProof [][32]byte
+}
+
+// Decode the amounts, root and proof of a cycle's merkle proof
+func ParseCycleMerkleProofs(proofs *stader_backend.CycleMerkleProofs) (ParsedCycleMerkleProofs, error) {
+	parsed := ParsedCycleMerkleProofs{}
+
+	root, err := parseBytes32(proofs.Root)
+	if err != nil {
+		return ParsedCycleMerkleProofs{}, fmt.Errorf("invalid root %s: %w", proofs.Root, err)
+	}
+	parsed.Root = root
+
+	amountSd, ok := big.NewInt(0).SetString(proofs.Sd, 10)
+	if !ok || amountSd.Sign() < 0 {
+		return ParsedCycleMerkleProofs{}, fmt.Errorf("could not parse sd amount %s", proofs.Sd)
+	}
+	parsed.AmountSd = amountSd
+
+	amountEth, ok := big.NewInt(0).SetString(proofs.Eth, 10)
+	if !ok || amountEth.Sign() < 0 {
+		return ParsedCycleMerkleProofs{}, fmt.Errorf("could not parse eth amount %s", proofs.Eth)
+	}
+	parsed.AmountEth = amountEth
+
+	parsed.MerkleProof = make([][32]byte, 0, len(proofs.Proof))
+	for _, proof := range proofs.Proof {
+		node, err := parseBytes32(proof)
+		if err != nil {
+			return ParsedCycleMerkleProofs{}, fmt.Errorf("invalid proof node %s: %w", proof, err)
+		}
+		parsed.MerkleProof = append(parsed.MerkleProof, node)
+	}
+
+	return parsed, nil
+}
+
+// Compute the socializing pool leaf of an operator, keccak256(abi.encodePacked(operator, amountSd, amountEth))
+func ComputeMerkleLeaf(operator common.Address, amountSd *big.Int, amountEth *big.Int) [32]byte {
+	return crypto.Keccak256Hash(
+		operator.Bytes(),
+		common.LeftPadBytes(amountSd.Bytes(), 32),
+		common.LeftPadBytes(amountEth.Bytes(), 32),
+	)
+}
+
+// Fold a merkle proof into the root it proves, hashing sorted pairs like OpenZeppelin's MerkleProof
+func ProcessMerkleProof(leaf [32]byte, merkleProof [][32]byte) [32]byte {
+	computedHash := leaf
+	for _, node := range merkleProof {
+		if bytes.Compare(computedHash[:], node[:]) < 0 {
+			computedHash = crypto.Keccak256Hash(computedHash[:], node[:])
+		} else {
+			computedHash = crypto.Keccak256Hash(node[:], computedHash[:])
+		}
+	}
+	return computedHash
+}
+
+// Check a cycle's merkle proof locally and against the socializing pool.
+// Verification failures are returned as a *MerkleProofVerificationError, anything else is a failure to reach the chain.
+func VerifyCycleMerkleProofs(sp *stader.SocializingPoolContractManager, operator common.Address, proofs *stader_backend.CycleMerkleProofs) error {
+	parsed, err := ParseCycleMerkleProofs(proofs)
+	if err != nil {
+		return &MerkleProofVerificationError{Cycle: proofs.Cycle, Failure: MerkleProofFailure_Malformed, Reason: err.Error()}
+	}
+
+	// Check the proof against the root the backend claims it belongs to
+	leaf := ComputeMerkleLeaf(operator, parsed.AmountSd, parsed.AmountEth)
+	computedRoot := ProcessMerkleProof(leaf, parsed.MerkleProof)
+	if computedRoot != parsed.Root {
+		return &MerkleProofVerificationError{
+			Cycle:   proofs.Cycle,
+			Failure: MerkleProofFailure_BackendRootMismatch,
+			Reason:  fmt.Sprintf("proof folds to %s but the backend root is %s", common.Hash(computedRoot).Hex(), common.Hash(parsed.Root).Hex()),
+		}
+	}
+
+	// Check the backend root against the one submitted on chain
+	cycle := big.NewInt(proofs.Cycle)
+	onChainRoot, err := socializing_pool.GetRewardsMerkleRoot(sp, cycle, nil)
+	if err != nil {
+		return fmt.Errorf("error getting the on-chain merkle root for cycle %d: %w", proofs.Cycle, err)
+	}
+	if onChainRoot == [32]byte{} {
+		return &MerkleProofVerificationError{Cycle: proofs.Cycle, Failure: MerkleProofFailure_NoOnChainRoot, Reason: "the cycle has no merkle root on chain"}
+	}
+	if onChainRoot != parsed.Root {
+		return &MerkleProofVerificationError{
+			Cycle:   proofs.Cycle,
+			Failure: MerkleProofFailure_OnChainRootMismatch,
+			Reason:  fmt.Sprintf("the backend root is %s but the on-chain root is %s", common.Hash(parsed.Root).Hex(), common.Hash(onChainRoot).Hex()),
+		}
+	}
+
+	// The contract reverts on empty claims, so there's nothing more to check for those
+	if parsed.AmountSd.Sign() == 0 && parsed.AmountEth.Sign() == 0 {
+		return nil
+	}
+
+	valid, err := socializing_pool.VerifyProof(sp, operator, cycle, parsed.AmountSd, parsed.AmountEth, parsed.MerkleProof, nil)
+	if err != nil {
+		return fmt.Errorf("error verifying the merkle proof for cycle %d on chain: %w", proofs.Cycle, err)
+	}
+	if !valid {
+		return &MerkleProofVerificationError{Cycle: proofs.Cycle, Failure: MerkleProofFailure_OnChainRejected, Reason: "the socializing pool rejected a proof that matches its merkle root"}
+	}
+
+	return nil
+}
+
+// Write a cycle's merkle proof to its cache file
+func SaveCycleMerkleProofs(cfg *config.StaderConfig, proofs *stader_backend.CycleMerkleProofs) error {
+	path, err := homedir.Expand(cfg.StaderNode.GetSpRewardCyclePath(proofs.Cycle, true))
+	if err != nil {
+		return err
+	}
+	if err := writeJSONFile(path, proofs); err != nil {
+		return err
+	}
+
+	// Drop a stale quarantine of the same cycle
+	quarantinePath, err := homedir.Expand(cfg.StaderNode.GetQuarantinedSpRewardCyclePath(proofs.Cycle, true))
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(quarantinePath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing the quarantined merkle proof for cycle %d: %w", proofs.Cycle, err)
+	}
+
+	return nil
+}
+
+// Move a cycle's merkle proof that failed verification to the quarantine folder, so it is never used for a claim
+func QuarantineCycleMerkleProofs(cfg *config.StaderConfig, proofs *stader_backend.CycleMerkleProofs, verificationErr *MerkleProofVerificationError) error {
+	quarantinePath, err := homedir.Expand(cfg.StaderNode.GetQuarantinedSpRewardCyclePath(proofs.Cycle, true))
+	if err != nil {
+		return err
+	}
+	err = writeJSONFile(quarantinePath, QuarantinedCycleMerkleProofs{
+		Proof:         *proofs,
+		Failure:       verificationErr.Failure,
+		Reason:        verificationErr.Reason,
+		QuarantinedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Make sure the bad proof isn't left in the cache
+	path, err := homedir.Expand(cfg.StaderNode.GetSpRewardCyclePath(proofs.Cycle, true))
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing the merkle proof for cycle %d from the cache: %w", proofs.Cycle, err)
+	}
+
+	return nil
+}
+
+// Verify the cached merkle proofs of the given cycles before they are claimed.
+// Proofs that fail are quarantined and an error naming them is returned.
+func VerifyCachedCycleMerkleProofs(cfg *config.StaderConfig, sp *stader.SocializingPoolContractManager, operator common.Address, cycles []*big.Int) error {
+	failures := []string{}
+	for _, cycle := range cycles {
+		proofs, exists, err := cfg.StaderNode.ReadCycleCache(cycle.Int64())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("merkle proof for cycle %d has not been downloaded", cycle.Int64())
+		}
+
+		err = VerifyCycleMerkleProofs(sp, operator, &proofs)
+		var verificationErr *MerkleProofVerificationError
+		if errors.As(err, &verificationErr) {
+			if err := QuarantineCycleMerkleProofs(cfg, &proofs, verificationErr); err != nil {
+				return err
+			}
+			failures = append(failures, verificationErr.Error())
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("refusing to claim with unverified merkle proofs, they have been quarantined: %v", failures)
+	}
+
+	return nil
+}
+
+// Decode a 0x-prefixed 32 byte hex string
+func parseBytes32(value string) ([32]byte, error) {
+	decoded, err := hexutil.Decode(value)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if len(decoded) != 32 {
+		return [32]byte{}, fmt.Errorf("expected 32 bytes, got %d", len(decoded))
+	}
+	var result [32]byte
+	copy(result[:], decoded)
+	return result, nil
+}
+
+// Write a value as JSON, creating its folder if needed
+func writeJSONFile(path string, value interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creating folder for %s: %w", path, err)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("error encoding JSON: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("error writing %s: %w", path, err)
+	}
+	return nil
+}
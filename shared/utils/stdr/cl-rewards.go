@@ -0,0 +1,106 @@
+package stdr
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	pool_utils "github.com/stader-labs/stader-node/stader-lib/pool-utils"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	stader_config "github.com/stader-labs/stader-node/stader-lib/stader-config"
+	"github.com/stader-labs/stader-node/stader-lib/tokens"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+type ClRewardsVaultState string
+
+const (
+	// The operator share is worth distributing
+	ClRewardsVaultState_Eligible ClRewardsVaultState = "eligible"
+	// The vault holds nothing for the operator
+	ClRewardsVaultState_NoRewards ClRewardsVaultState = "no-rewards"
+	// The operator share isn't worth the gas yet
+	ClRewardsVaultState_BelowMinimum ClRewardsVaultState = "below-minimum"
+	// The vault holds more than rewards, it is settled by the oracles once the validator has exited
+	ClRewardsVaultState_AboveThreshold ClRewardsVaultState = "above-threshold"
+)
+
+// The CL rewards held by a validator's withdraw vault
+type ClRewardsVault struct {
+	Pubkey               types.ValidatorPubkey `json:"pubkey"`
+	WithdrawVaultAddress common.Address        `json:"withdrawVaultAddress"`
+	Balance              *big.Int              `json:"balance"`
+	OperatorShare        *big.Int              `json:"operatorShare"`
+	State                ClRewardsVaultState   `json:"state"`
+}
+
+// Get the CL rewards of the withdraw vault of every non-settled validator of the operator.
+// Vaults are eligible when their operator share is at least the minimum and no more than the rewards threshold.
+func GetClRewardsVaults(pnr *stader.PermissionlessNodeRegistryContractManager, putils *stader.PoolUtilsContractManager, sdcfg *stader.StaderConfigContractManager, operatorAddress common.Address, minimum *big.Int, opts *bind.CallOpts) ([]ClRewardsVault, error) {
+	opts, err := stader.PinCallOpts(pnr.Client, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, validatorPubKeys, err := GetAllValidatorsRegisteredWithOperator(pnr, nil, operatorAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardsThreshold, err := stader_config.GetRewardsThreshold(sdcfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	vaults := []ClRewardsVault{}
+	for _, validatorPubKey := range validatorPubKeys {
+		validatorInfo := validators[validatorPubKey]
+		if validatorInfo.Status == 5 || IsValidatorTerminal(validatorInfo) {
+			continue
+		}
+		vaults = append(vaults, ClRewardsVault{
+			Pubkey:               validatorPubKey,
+			WithdrawVaultAddress: validatorInfo.WithdrawVaultAddress,
+		})
+	}
+
+	mc, err := stader.NewMultiCaller(pnr.Client)
+	if err != nil {
+		return nil, err
+	}
+	for i := range vaults {
+		if err := tokens.AddGetEthBalanceCall(mc, vaults[i].WithdrawVaultAddress, &vaults[i].Balance); err != nil {
+			return nil, err
+		}
+	}
+	if err := mc.Execute(opts); err != nil {
+		return nil, err
+	}
+
+	rewardShares := make([]types.RewardShare, len(vaults))
+	for i := range vaults {
+		if err := pool_utils.AddCalculateRewardShareCall(mc, putils, 1, vaults[i].Balance, &rewardShares[i]); err != nil {
+			return nil, err
+		}
+	}
+	if err := mc.Execute(opts); err != nil {
+		return nil, err
+	}
+
+	for i := range vaults {
+		vault := &vaults[i]
+		vault.OperatorShare = rewardShares[i].OperatorShare
+		switch {
+		case vault.OperatorShare.Sign() == 0:
+			vault.State = ClRewardsVaultState_NoRewards
+		case vault.OperatorShare.Cmp(rewardsThreshold) > 0:
+			vault.State = ClRewardsVaultState_AboveThreshold
+		case vault.OperatorShare.Cmp(minimum) < 0:
+			vault.State = ClRewardsVaultState_BelowMinimum
+		default:
+			vault.State = ClRewardsVaultState_Eligible
+		}
+	}
+
+	return vaults, nil
+}
@@ -0,0 +1,71 @@
+package stdr
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	socializing_pool "github.com/stader-labs/stader-node/stader-lib/socializing-pool"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+type SpRewardCycles struct {
+	SocializingPoolContractPaused bool
+	ClaimedCycles                 []*big.Int
+	UnclaimedCycles               []*big.Int
+}
+
+// Get the socializing pool reward cycles the operator has and hasn't claimed yet
+func GetSpRewardCycles(sp *stader.SocializingPoolContractManager, operatorAddress common.Address, opts *bind.CallOpts) (*SpRewardCycles, error) {
+	rewardCycles := SpRewardCycles{
+		ClaimedCycles:   []*big.Int{},
+		UnclaimedCycles: []*big.Int{},
+	}
+
+	opts, err := stader.PinCallOpts(sp.Client, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	isPaused, err := socializing_pool.IsSocializingPoolPaused(sp, opts)
+	if err != nil {
+		return nil, err
+	}
+	if isPaused {
+		rewardCycles.SocializingPoolContractPaused = true
+		return &rewardCycles, nil
+	}
+
+	rewardDetails, err := socializing_pool.GetRewardDetails(sp, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	mc, err := stader.NewMultiCaller(sp.Client)
+	if err != nil {
+		return nil, err
+	}
+	cycles := []*big.Int{}
+	for i := int64(1); i < rewardDetails.CurrentIndex.Int64(); i++ {
+		cycles = append(cycles, big.NewInt(i))
+	}
+	claimed := make([]bool, len(cycles))
+	for i, cycle := range cycles {
+		if err := socializing_pool.AddHasClaimedRewardsCall(mc, sp, operatorAddress, cycle, &claimed[i]); err != nil {
+			return nil, err
+		}
+	}
+	if err := mc.Execute(opts); err != nil {
+		return nil, err
+	}
+
+	for i, cycle := range cycles {
+		if claimed[i] {
+			rewardCycles.ClaimedCycles = append(rewardCycles.ClaimedCycles, cycle)
+		} else {
+			rewardCycles.UnclaimedCycles = append(rewardCycles.UnclaimedCycles, cycle)
+		}
+	}
+
+	return &rewardCycles, nil
+}
@@ -20,13 +20,15 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package validator
 
 import (
+	"sync"
+
 	"github.com/stader-labs/stader-node/shared/types/eth2"
 	"github.com/stader-labs/stader-node/stader-lib/types"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 )
 
-// Get a voluntary exit message signature for a given validator key and index
-func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex uint64, epoch uint64, signatureDomain []byte) (types.ValidatorSignature, [32]byte, error) {
+// Get the signing root of a voluntary exit message for a given validator index and domain
+func GetExitMessageSigningRoot(validatorIndex uint64, epoch uint64, signatureDomain []byte) ([32]byte, error) {
 
 	// Build voluntary exit message
 	exitMessage := eth2.VoluntaryExit{
@@ -37,7 +39,7 @@ func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex
 	// Get object root
 	or, err := exitMessage.HashTreeRoot()
 	if err != nil {
-		return types.ValidatorSignature{}, [32]byte{}, err
+		return [32]byte{}, err
 	}
 
 	// Get signing root
@@ -46,7 +48,14 @@ func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex
 		Domain:     signatureDomain,
 	}
 
-	srHash, err := sr.HashTreeRoot()
+	return sr.HashTreeRoot()
+
+}
+
+// Get a voluntary exit message signature for a given validator key and index
+func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex uint64, epoch uint64, signatureDomain []byte) (types.ValidatorSignature, [32]byte, error) {
+
+	srHash, err := GetExitMessageSigningRoot(validatorIndex, epoch, signatureDomain)
 	if err != nil {
 		return types.ValidatorSignature{}, [32]byte{}, err
 	}
@@ -58,3 +67,35 @@ func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex
 	return types.BytesToValidatorSignature(signature), srHash, nil
 
 }
+
+// Initialize BLS support; the error is kept so every call reports a failed initialization
+var initBLS sync.Once
+var initBLSErr error
+
+// Check that a voluntary exit message signature was made by a validator's key for the given domain
+func VerifyExitMessage(pubkey types.ValidatorPubkey, validatorIndex uint64, epoch uint64, signatureDomain []byte, signature types.ValidatorSignature) (bool, error) {
+	initBLS.Do(func() {
+		initBLSErr = eth2types.InitBLS()
+	})
+	if initBLSErr != nil {
+		return false, initBLSErr
+	}
+
+	// Get signing root
+	srHash, err := GetExitMessageSigningRoot(validatorIndex, epoch, signatureDomain)
+	if err != nil {
+		return false, err
+	}
+
+	// Verify signature
+	blsPubkey, err := eth2types.BLSPublicKeyFromBytes(pubkey.Bytes())
+	if err != nil {
+		return false, err
+	}
+	blsSignature, err := eth2types.BLSSignatureFromBytes(signature.Bytes())
+	if err != nil {
+		return false, err
+	}
+	return blsSignature.Verify(srHash[:], blsPubkey), nil
+
+}
@@ -0,0 +1,69 @@
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	"github.com/stader-labs/stader-node/shared/services/txs"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+)
+
+func broadcastTx(c *cli.Context, path string) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Read the signed transaction, saved by `stader-cli wallet sign-tx` or as a raw hex string
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read signed transaction from %s: %w", path, err)
+	}
+	var signedTx txs.SignedTransaction
+	if err := json.Unmarshal(bytes, &signedTx); err != nil {
+		signedTx.RawTransaction, err = hexutil.Decode(strings.TrimSpace(string(bytes)))
+		if err != nil {
+			return fmt.Errorf("%s is neither a signed transaction file nor a raw transaction hex string", path)
+		}
+	}
+	if len(signedTx.RawTransaction) == 0 {
+		return fmt.Errorf("%s has no raw transaction", path)
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to send this transaction?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Send it
+	response, err := staderClient.BroadcastTx(signedTx.RawTransaction)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Sending the signed transaction...")
+	cliutils.PrintTransactionHash(staderClient, response.TxHash)
+	if _, err = staderClient.WaitForTransaction(response.TxHash); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Printf("Transaction %s was included in a block.\n", response.TxHash.Hex())
+	return nil
+
+}
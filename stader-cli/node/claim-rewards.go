@@ -49,10 +49,14 @@ func ClaimRewards(c *cli.Context) error {
 	}
 
 	// Withdraw El Rewards
+	staderClient.SetUnsignedTx(c.String("unsigned-out") != "")
 	res, err := staderClient.ClaimRewards()
 	if err != nil {
 		return err
 	}
+	if res.UnsignedTx != nil {
+		return cliutils.SaveUnsignedTx(c.String("unsigned-out"), res.UnsignedTx)
+	}
 	fmt.Printf("Withdrawing %.6f ETH Rewards to Operator Reward Address: %s\n\n", math.RoundDown(eth.WeiToEth(res.OperatorRewardsBalance), 6), res.OperatorRewardAddress)
 	cliutils.PrintTransactionHash(staderClient, res.TxHash)
 	if _, err = staderClient.WaitForTransaction(res.TxHash); err != nil {
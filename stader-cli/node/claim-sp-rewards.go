@@ -33,6 +33,7 @@ func ClaimSpRewards(c *cli.Context) error {
 	if len(downloadRes.DownloadedCycles) != 0 {
 		fmt.Printf("Merkle proofs downloaded for cycles %v!\n", downloadRes.DownloadedCycles)
 	}
+	printQuarantinedMerkleProofs(downloadRes.QuarantinedCycles)
 
 	// prompt user to select the cycles to claim from
 	canClaimSpRewards, err := staderClient.CanClaimSpRewards()
@@ -153,10 +154,14 @@ func ClaimSpRewards(c *cli.Context) error {
 	}
 
 	fmt.Printf("Claiming rewards for cycles %v\n", cyclesToClaimArray)
+	staderClient.SetUnsignedTx(c.String("unsigned-out") != "")
 	res, err := staderClient.ClaimSpRewards(cyclesToClaimArray)
 	if err != nil {
 		return err
 	}
+	if res.UnsignedTx != nil {
+		return cliutils.SaveUnsignedTx(c.String("unsigned-out"), res.UnsignedTx)
+	}
 
 	cliutils.PrintTransactionHash(staderClient, res.TxHash)
 	_, err = staderClient.WaitForTransaction(res.TxHash)
@@ -37,13 +37,26 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Name:      "status",
 				Aliases:   []string{"s"},
 				Usage:     "Get the node's status",
-				UsageText: "stader-cli node status",
+				UsageText: "stader-cli node status [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "block, b",
+						Usage: "Show the status as of this EL block (requires archive clients for old blocks)",
+					},
+					cli.Uint64Flag{
+						Name:  "slot, s",
+						Usage: "Show the status as of this Beacon slot (requires archive clients for old slots)",
+					},
+				},
 				Action: func(c *cli.Context) error {
 
 					// Validate args
 					if err := cliutils.ValidateArgCount(c, 0); err != nil {
 						return err
 					}
+					if c.Uint64("block") != 0 && c.Uint64("slot") != 0 {
+						return fmt.Errorf("only one of --block and --slot can be set")
+					}
 
 					// Run
 					return getNodeStatus(c)
@@ -81,6 +94,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "yes, y",
 						Usage: "Automatically confirm socialize-el update",
 					},
+					cli.StringFlag{
+						Name:  "unsigned-out",
+						Usage: "Write the unsigned transaction to this file instead of sending it, so it can be signed offline with `stader-cli wallet sign-tx`",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -117,6 +134,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "yes, y",
 						Usage: "Automatically confirm node registration",
 					},
+					cli.StringFlag{
+						Name:  "unsigned-out",
+						Usage: "Write the unsigned transaction to this file instead of sending it, so it can be signed offline with `stader-cli wallet sign-tx`",
+					},
 				},
 				Action: func(c *cli.Context) error {
 					// Validate flags
@@ -161,6 +182,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "yes, y",
 						Usage: "Automatically confirm SD deposit",
 					},
+					cli.StringFlag{
+						Name:  "unsigned-out",
+						Usage: "Write the unsigned transaction to this file instead of sending it, so it can be signed offline with `stader-cli wallet sign-tx`",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -205,6 +230,131 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "broadcast",
+				Usage:     "Send a transaction signed offline with `stader-cli wallet sign-tx` and wait for it to be included in a block",
+				UsageText: "stader-cli node broadcast [options] signed-tx-file",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm sending the transaction",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return broadcastTx(c, c.Args().Get(0))
+
+				},
+			},
+			{
+				Name:      "events",
+				Usage:     "List the contract events of the operator, its validators and vaults indexed by the node daemon",
+				UsageText: "stader-cli node events [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "limit, l",
+						Usage: "The number of most recent events to list, 0 for all of them",
+						Value: 20,
+					},
+					cli.StringFlag{
+						Name:  "name, n",
+						Usage: "Only list the events with this name, e.g. AddedValidatorKey",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return listEvents(c)
+
+				},
+			},
+			{
+				Name:    "tx",
+				Aliases: []string{"t"},
+				Usage:   "Manage the pending transactions sent by the node wallet",
+				Subcommands: []cli.Command{
+					{
+						Name:      "list",
+						Aliases:   []string{"l"},
+						Usage:     "List the pending transactions sent by the node wallet",
+						UsageText: "stader-cli node tx list",
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return listTransactions(c)
+
+						},
+					},
+					{
+						Name:      "speed-up",
+						Usage:     "Send a pending transaction again with its fees raised by at least the replacement minimum",
+						UsageText: "stader-cli node tx speed-up [options] tx-hash",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm sending the transaction again",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+							hash, err := cliutils.ValidateTxHash("tx hash", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return replaceTransaction(c, hash, false)
+
+						},
+					},
+					{
+						Name:      "cancel",
+						Usage:     "Cancel a pending transaction by sending 0 ETH to the node account with its nonce",
+						UsageText: "stader-cli node tx cancel [options] tx-hash",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm cancelling the transaction",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+							hash, err := cliutils.ValidateTxHash("tx hash", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return replaceTransaction(c, hash, true)
+
+						},
+					},
+				},
+			},
 			{
 				Name:      "get-contracts-info",
 				Aliases:   []string{"c"},
@@ -240,10 +390,16 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Aliases:   []string{"wer"},
 				Usage:     "Claim rewards from claim vault to the operator reward address",
 				UsageText: "stader-cli node claim-rewards",
-				Flags: []cli.Flag{cli.BoolFlag{
-					Name:  "yes, y",
-					Usage: "Automatically confirm rewards transfer to operator reward address",
-				}},
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm rewards transfer to operator reward address",
+					},
+					cli.StringFlag{
+						Name:  "unsigned-out",
+						Usage: "Write the unsigned transaction to this file instead of sending it, so it can be signed offline with `stader-cli wallet sign-tx`",
+					},
+				},
 				Action: func(c *cli.Context) error {
 					// Run
 					return ClaimRewards(c)
@@ -263,6 +419,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "yes, y",
 						Usage: "Automatically confirm withdraw sd collateral",
 					},
+					cli.StringFlag{
+						Name:  "unsigned-out",
+						Usage: "Write the unsigned transaction to this file instead of sending it, so it can be signed offline with `stader-cli wallet sign-tx`",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -305,6 +465,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "yes, y",
 						Usage: "Automatically confirm claim of rewards",
 					},
+					cli.StringFlag{
+						Name:  "unsigned-out",
+						Usage: "Write the unsigned transaction to this file instead of sending it, so it can be signed offline with `stader-cli wallet sign-tx`",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -330,6 +494,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "yes, y",
 						Usage: "Automatically confirm claim of rewards",
 					},
+					cli.StringFlag{
+						Name:  "unsigned-out",
+						Usage: "Write the unsigned transaction to this file instead of sending it, so it can be signed offline with `stader-cli wallet sign-tx`",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -356,6 +524,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "yes, y",
 						Usage: "Automatically confirm claim of rewards",
 					},
+					cli.StringFlag{
+						Name:  "unsigned-out",
+						Usage: "Write the unsigned transaction to this file instead of sending it, so it can be signed offline with `stader-cli wallet sign-tx`",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -77,10 +77,15 @@ func nodeDepositSd(c *cli.Context) error {
 			return nil
 		}
 
+		staderClient.SetUnsignedTx(c.String("unsigned-out") != "")
 		response, err := staderClient.NodeDepositSdApprove(maxApproval)
 		if err != nil {
 			return err
 		}
+		if response.UnsignedTx != nil {
+			fmt.Println("The approval has to be sent before the SD deposit can be built. Once it has been broadcast and included in a block, run this command again to build the deposit.")
+			return cliutils.SaveUnsignedTx(c.String("unsigned-out"), response.UnsignedTx)
+		}
 		hash := response.ApproveTxHash
 		fmt.Printf("Approving SD for depositing...\n")
 		cliutils.PrintTransactionHash(staderClient, hash)
@@ -120,10 +125,14 @@ func nodeDepositSd(c *cli.Context) error {
 		return nil
 	}
 
+	staderClient.SetUnsignedTx(c.String("unsigned-out") != "")
 	depositSdResponse, err := staderClient.NodeDepositSd(amountWei)
 	if err != nil {
 		return err
 	}
+	if depositSdResponse.UnsignedTx != nil {
+		return cliutils.SaveUnsignedTx(c.String("unsigned-out"), depositSdResponse.UnsignedTx)
+	}
 
 	fmt.Printf("Depositing SD...\n")
 	cliutils.PrintTransactionHash(staderClient, depositSdResponse.DepositTxHash)
@@ -3,7 +3,9 @@ package node
 import (
 	"fmt"
 	"github.com/stader-labs/stader-node/shared/services/stader"
+	"github.com/stader-labs/stader-node/shared/types/api"
 	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
 	"github.com/urfave/cli"
 )
 
@@ -47,6 +49,19 @@ func downloadSPMerkleProofs(c *cli.Context) error {
 	}
 
 	fmt.Printf("Successfully downloaded the merkle proofs for cycles: %v\n", res.DownloadedCycles)
+	printQuarantinedMerkleProofs(res.QuarantinedCycles)
 
 	return nil
 }
+
+func printQuarantinedMerkleProofs(quarantinedCycles []api.QuarantinedMerkleProof) {
+	if len(quarantinedCycles) == 0 {
+		return
+	}
+
+	fmt.Printf("%sWARNING: The merkle proofs for the following cycles failed verification and have been quarantined. They will not be used for claims:%s\n", log.ColorYellow, log.ColorReset)
+	for _, quarantined := range quarantinedCycles {
+		fmt.Printf("  Cycle %d (%s): %s\n", quarantined.Cycle, quarantined.Failure, quarantined.Reason)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,90 @@
+package node
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/events"
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+)
+
+func listEvents(c *cli.Context) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Get the events, all of them when filtering by name
+	name := c.String("name")
+	limit := c.Uint64("limit")
+	requestLimit := limit
+	if name != "" {
+		requestLimit = 0
+	}
+	response, err := staderClient.GetNodeEvents(requestLimit)
+	if err != nil {
+		return err
+	}
+	if !response.Indexed {
+		fmt.Printf("No events have been indexed for the node yet. Enable `Enable Event Indexer` in the %sstader-cli service config%s Stadernode settings to let the node daemon index them.\n", log.ColorGreen, log.ColorReset)
+		return nil
+	}
+
+	// Print the indexing progress
+	if !response.Cursor.Onboarded {
+		fmt.Printf("The node daemon is still searching for the block the operator was onboarded in, it has reached block %d.\n", response.Cursor.LastBlock)
+		return nil
+	}
+	fmt.Printf("%d events indexed from block %d to block %d.\n\n", response.TotalEvents, response.Cursor.StartBlock, response.Cursor.LastBlock)
+
+	// Filter them
+	nodeEvents := []events.Event{}
+	for _, event := range response.Events {
+		if name == "" || event.Name == name {
+			nodeEvents = append(nodeEvents, event)
+		}
+	}
+	if limit > 0 && uint64(len(nodeEvents)) > limit {
+		nodeEvents = nodeEvents[uint64(len(nodeEvents))-limit:]
+	}
+	if len(nodeEvents) == 0 {
+		fmt.Println("No matching events.")
+		return nil
+	}
+
+	// Print them
+	for _, event := range nodeEvents {
+		printEvent(event)
+		fmt.Println()
+	}
+	return nil
+
+}
+
+func printEvent(event events.Event) {
+	fmt.Printf("%s.%s\n", event.Contract, event.Name)
+	fmt.Printf("\tBlock:    %d\n", event.BlockNumber)
+	fmt.Printf("\tTx:       %s\n", event.TxHash.Hex())
+	fmt.Printf("\tContract: %s\n", event.Address.Hex())
+
+	names := make([]string, 0, len(event.Args))
+	for name := range event.Args {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf("\t%s: %s\n", name, event.Args[name])
+	}
+}
@@ -70,10 +70,14 @@ func registerNode(c *cli.Context, operatorName string, socializeEl bool) error {
 	}
 
 	// Register node
+	staderClient.SetUnsignedTx(c.String("unsigned-out") != "")
 	response, err := staderClient.RegisterNode(operatorName, common.HexToAddress(operatorRewardAddressString), socializeEl)
 	if err != nil {
 		return err
 	}
+	if response.UnsignedTx != nil {
+		return cliutils.SaveUnsignedTx(c.String("unsigned-out"), response.UnsignedTx)
+	}
 
 	fmt.Printf("Registering node...\n")
 	cliutils.PrintTransactionHash(staderClient, response.TxHash)
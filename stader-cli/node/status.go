@@ -32,10 +32,13 @@ func getNodeStatus(c *cli.Context) error {
 	}
 
 	// Get node status
-	status, err := staderClient.NodeStatus()
+	status, err := staderClient.NodeStatusAt(c.Uint64("block"), c.Uint64("slot"))
 	if err != nil {
 		return err
 	}
+	if c.Uint64("block") != 0 || c.Uint64("slot") != 0 {
+		fmt.Printf("Showing the status as of EL block %d (Beacon slot %d).\n\n", status.SnapshotElBlockNumber, status.SnapshotBeaconSlot)
+	}
 
 	totalRegisteredValidators := status.TotalNonTerminalValidators
 	totalRegisterableValidators := status.SdCollateralWorthValidators
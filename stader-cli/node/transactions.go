@@ -0,0 +1,180 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/gas"
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	"github.com/stader-labs/stader-node/shared/services/txs"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/shared/utils/math"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+func listTransactions(c *cli.Context) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Get the pending transactions
+	response, err := staderClient.GetPendingTransactions()
+	if err != nil {
+		return err
+	}
+	if len(response.Transactions) == 0 {
+		fmt.Println("The node wallet has no pending transactions.")
+		return nil
+	}
+
+	// Print them
+	fmt.Printf("The node wallet has %d pending transaction(s):\n\n", len(response.Transactions))
+	for _, tx := range response.Transactions {
+		printTransaction(tx)
+		fmt.Println()
+	}
+	fmt.Println("Use `stader-cli node tx speed-up` to send one again with higher fees, or `stader-cli node tx cancel` to cancel it.")
+	return nil
+
+}
+
+func replaceTransaction(c *cli.Context, hash common.Hash, cancel bool) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Check the transaction can be replaced
+	var canReplace api.CanReplaceTransactionResponse
+	if cancel {
+		canReplace, err = staderClient.CanCancelTransaction(hash)
+	} else {
+		canReplace, err = staderClient.CanSpeedUpTransaction(hash)
+	}
+	if err != nil {
+		return err
+	}
+	if canReplace.NotFound {
+		fmt.Printf("Transaction %s was not sent by the node wallet, or its record has been removed.\n", hash.Hex())
+		return nil
+	}
+	tx := canReplace.Transaction
+	if tx.Hash != hash {
+		fmt.Printf("Transaction %s was replaced by transaction %s.\n", hash.Hex(), tx.Hash.Hex())
+	}
+	if canReplace.NotPending {
+		fmt.Printf("Transaction %s is no longer pending, it is %s.\n", tx.Hash.Hex(), tx.Status)
+		return nil
+	}
+	printTransaction(tx)
+	fmt.Println()
+
+	// Assign max fees
+	err = gas.AssignMaxFeeAndLimit(canReplace.GasInfo, staderClient, c.Bool("yes"))
+	if err != nil {
+		return err
+	}
+
+	// Raise the fees to the replacement minimum, which execution clients require to accept the replacement
+	maxFeeGwei, maxPriorityFeeGwei, gasLimit := staderClient.GetGasSettings()
+	maxFee, maxPriorityFee := txs.GetReplacementFees(tx, eth.GweiToWei(maxFeeGwei), eth.GweiToWei(maxPriorityFeeGwei))
+	if maxFee.Cmp(eth.GweiToWei(maxFeeGwei)) != 0 || maxPriorityFee.Cmp(eth.GweiToWei(maxPriorityFeeGwei)) != 0 {
+		fmt.Printf("%sReplacing a transaction requires raising both of its fees by at least %d%%; the replacement will use a max fee of %.6f Gwei and a priority fee of %.6f Gwei.%s\n",
+			log.ColorYellow, txs.ReplacementFeeBumpPercent, eth.WeiToGwei(maxFee), eth.WeiToGwei(maxPriorityFee), log.ColorReset)
+	}
+	staderClient.AssignGasSettings(eth.WeiToGwei(maxFee), eth.WeiToGwei(maxPriorityFee), gasLimit)
+
+	// Prompt for confirmation
+	var prompt string
+	if cancel {
+		prompt = fmt.Sprintf("Are you sure you want to cancel transaction %s by sending 0 ETH to the node account with nonce %d?", tx.Hash.Hex(), tx.Nonce)
+	} else {
+		prompt = fmt.Sprintf("Are you sure you want to send transaction %s again with higher fees?", tx.Hash.Hex())
+	}
+	if !(c.Bool("yes") || cliutils.Confirm(prompt)) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Replace the transaction
+	var response api.ReplaceTransactionResponse
+	if cancel {
+		response, err = staderClient.CancelTransaction(tx.Hash)
+	} else {
+		response, err = staderClient.SpeedUpTransaction(tx.Hash)
+	}
+	if err != nil {
+		return err
+	}
+
+	if cancel {
+		fmt.Printf("Cancelling transaction %s...\n", tx.Hash.Hex())
+	} else {
+		fmt.Printf("Speeding up transaction %s...\n", tx.Hash.Hex())
+	}
+	cliutils.PrintTransactionHash(staderClient, response.TxHash)
+	if _, err = staderClient.WaitForTransaction(response.TxHash); err != nil {
+		return err
+	}
+
+	// Log & return
+	if cancel {
+		fmt.Printf("Successfully cancelled transaction %s.\n", tx.Hash.Hex())
+	} else {
+		fmt.Printf("Transaction %s was replaced by %s, which has been included in a block.\n", tx.Hash.Hex(), response.TxHash.Hex())
+	}
+	return nil
+
+}
+
+// Print the details of a transaction sent by the node wallet
+func printTransaction(tx txs.Transaction) {
+	fmt.Printf("Transaction %s\n", tx.Hash.Hex())
+	fmt.Printf("\tNonce:        %d\n", tx.Nonce)
+	if tx.To != nil {
+		fmt.Printf("\tTo:           %s\n", tx.To.Hex())
+	}
+	if tx.Value != nil && tx.Value.Sign() > 0 {
+		fmt.Printf("\tValue:        %.6f ETH\n", math.RoundDown(eth.WeiToEth(tx.Value), 6))
+	}
+	fmt.Printf("\tMax fee:      %.6f Gwei\n", weiToGwei(tx.GasFeeCap))
+	fmt.Printf("\tPriority fee: %.6f Gwei\n", weiToGwei(tx.GasTipCap))
+	fmt.Printf("\tGas limit:    %d\n", tx.GasLimit)
+	if tx.Command != "" {
+		fmt.Printf("\tSent by:      %s\n", tx.Command)
+	}
+	if tx.Kind != txs.TxKind_Original {
+		fmt.Printf("\tType:         %s\n", tx.Kind)
+	}
+	fmt.Printf("\tSent at:      %s\n", tx.SentAt.Local().Format("2006-01-02 15:04:05 MST"))
+}
+
+// Convert a possibly unset fee to Gwei
+func weiToGwei(fee *big.Int) float64 {
+	if fee == nil {
+		return 0
+	}
+	return eth.WeiToGwei(fee)
+}
@@ -50,10 +50,14 @@ func updateOperatorName(c *cli.Context, operatorName string) error {
 	}
 
 	// update the socializing pool el
+	staderClient.SetUnsignedTx(c.String("unsigned-out") != "")
 	response, err := staderClient.UpdateOperatorName(operatorName)
 	if err != nil {
 		return err
 	}
+	if response.UnsignedTx != nil {
+		return cliutils.SaveUnsignedTx(c.String("unsigned-out"), response.UnsignedTx)
+	}
 
 	fmt.Println("Updating operator name...")
 
@@ -51,10 +51,14 @@ func updateOperatorRewardAddress(c *cli.Context, operatorRewardAddress common.Ad
 	}
 
 	// update the socializing pool el
+	staderClient.SetUnsignedTx(c.String("unsigned-out") != "")
 	response, err := staderClient.UpdateOperatorRewardAddress(operatorRewardAddress)
 	if err != nil {
 		return err
 	}
+	if response.UnsignedTx != nil {
+		return cliutils.SaveUnsignedTx(c.String("unsigned-out"), response.UnsignedTx)
+	}
 
 	fmt.Println("Updating operator reward address...")
 
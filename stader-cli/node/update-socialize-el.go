@@ -57,10 +57,14 @@ func UpdateSocializeEl(c *cli.Context, socializeEl bool) error {
 	}
 
 	// update the socializing pool el
+	staderClient.SetUnsignedTx(c.String("unsigned-out") != "")
 	response, err := staderClient.UpdateSocializeEl(socializeEl)
 	if err != nil {
 		return err
 	}
+	if response.UnsignedTx != nil {
+		return cliutils.SaveUnsignedTx(c.String("unsigned-out"), response.UnsignedTx)
+	}
 
 	if socializeEl {
 		fmt.Printf("Opting in for socializing pool...\n")
@@ -57,10 +57,14 @@ func WithdrawSd(c *cli.Context) error {
 		return nil
 	}
 
+	staderClient.SetUnsignedTx(c.String("unsigned-out") != "")
 	res, err := staderClient.WithdrawSd(amountWei)
 	if err != nil {
 		return err
 	}
+	if res.UnsignedTx != nil {
+		return cliutils.SaveUnsignedTx(c.String("unsigned-out"), res.UnsignedTx)
+	}
 
 	fmt.Printf("Withdrawing %s SD from the collateral contract.\n", amountInString)
 	cliutils.PrintTransactionHash(staderClient, res.TxHash)
@@ -29,6 +29,7 @@ import (
 	"github.com/stader-labs/stader-node/shared/services/config"
 	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
 	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/stader-lib/types"
 )
 
 // Creates CLI argument flags from the parameters of the configuration struct
@@ -215,6 +216,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "ignore-slash-timer",
 						Usage: "Bypass the safety timer that forces a delay when switching to a new ETH2 client",
 					},
+					cli.BoolFlag{
+						Name:  "ignore-slashing-protection",
+						Usage: "Start even if the validator client is missing the slashing protection history of imported validator keys it already loaded. Switching clients still requires moving the history.",
+					},
 					cli.BoolFlag{
 						Name:  "yes, y",
 						Usage: "Ignore service config prompt after upgrading",
@@ -480,6 +485,78 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "export-slashing-protection",
+				Usage:     "Exports the slashing protection history of your validator client to an EIP-3076 interchange file. Use this before switching validator clients.",
+				UsageText: "stader-cli service export-slashing-protection [options] target-file",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					targetFile := c.Args().Get(0)
+
+					// Run command
+					return exportSlashingProtection(c, targetFile)
+
+				},
+			},
+
+			{
+				Name:      "import-slashing-protection",
+				Usage:     "Imports EIP-3076 interchange files into the slashing protection history of your selected validator client. Several files are merged into one history.",
+				UsageText: "stader-cli service import-slashing-protection [options] source-file [source-file...]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if len(c.Args()) == 0 {
+						return fmt.Errorf("incorrect argument count; usage: %s", c.Command.UsageText)
+					}
+
+					// Run command
+					return importSlashingProtection(c, c.Args())
+
+				},
+			},
+
+			{
+				Name:      "confirm-no-slashing-history",
+				Usage:     "Confirms that imported validator keys have never signed a block or attestation, so they are loaded into your validator client without slashing protection history. Each key is confirmed separately.",
+				UsageText: "stader-cli service confirm-no-slashing-history validator-pubkey [validator-pubkey...]",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if len(c.Args()) == 0 {
+						return fmt.Errorf("incorrect argument count; usage: %s", c.Command.UsageText)
+					}
+					pubkeys := make([]types.ValidatorPubkey, len(c.Args()))
+					for i, arg := range c.Args() {
+						pubkey, err := cliutils.ValidatePubkey("validator-pubkey", arg)
+						if err != nil {
+							return err
+						}
+						pubkeys[i] = pubkey
+					}
+
+					// Run command
+					return confirmNoSlashingHistory(c, pubkeys)
+
+				},
+			},
+
 			{
 				Name:      "resync-eth1",
 				Usage:     fmt.Sprintf("%sDeletes the main ETH1 client's chain data and resyncs it from scratch. Only use this as a last resort!%s", colorRed, colorReset),
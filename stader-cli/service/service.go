@@ -660,6 +660,18 @@ func startService(c *cli.Context, ignoreConfigSuggestion bool) error {
 		fmt.Printf("%sIgnoring anti-slashing safety delay.%s\n", colorYellow, colorReset)
 	}
 
+	// Make sure the validator client has the slashing protection history of its validators
+	protected, err := checkSlashingProtection(staderClient, cfg, c.Bool("ignore-slashing-protection"))
+	if err != nil {
+		fmt.Printf("%sWarning: couldn't verify the slashing protection history of your validator client:\n\t%s%s\n", colorYellow, err.Error(), colorReset)
+		if !cliutils.Confirm("Do you want to start Stader anyway?") {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	} else if !protected {
+		return nil
+	}
+
 	// Write a note on doppelganger protection
 	doppelgangerEnabled, err := cfg.IsDoppelgangerEnabled()
 	if err != nil {
@@ -676,6 +688,16 @@ func startService(c *cli.Context, ignoreConfigSuggestion bool) error {
 		return err
 	}
 
+	// Load the held imported keys that are now protected into the validator client
+	if !cfg.IsNativeMode {
+		heldKeys, err := hasHeldImportedKeys(cfg)
+		if err != nil {
+			fmt.Printf("%sCouldn't check for held imported validator keys: %s%s\n", colorYellow, err.Error(), colorReset)
+		} else if heldKeys {
+			releaseImportedKeys(staderClient)
+		}
+	}
+
 	// Remove the upgrade flag if it's there
 	return staderClient.RemoveUpgradeFlagFile()
 
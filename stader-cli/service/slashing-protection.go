@@ -0,0 +1,558 @@
+package service
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/eth2"
+	"github.com/stader-labs/stader-node/shared/utils/slashing"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Settings
+const (
+	SlashingProtectionContainerSuffix string = "_slashing_protection"
+)
+
+// Export the slashing protection history of the deployed validator client to an EIP-3076 interchange file
+func exportSlashingProtection(c *cli.Context, targetFile string) (err error) {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Get the config
+	cfg, err := loadSlashingProtectionConfig(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Make the path absolute and make sure it's free
+	targetFile, err = filepath.Abs(targetFile)
+	if err != nil {
+		return fmt.Errorf("Error converting to absolute path: %w", err)
+	}
+	if _, err := os.Stat(targetFile); err == nil {
+		return fmt.Errorf("Target file [%s] already exists.", targetFile)
+	}
+
+	// Get the validator client that holds the history
+	prefix, err := getContainerPrefix(staderClient)
+	if err != nil {
+		return fmt.Errorf("Error getting container prefix: %w", err)
+	}
+	validatorContainerName := prefix + ValidatorContainerSuffix
+	deployedClient, deployedImage, err := getDeployedValidatorClient(staderClient, validatorContainerName)
+	if err != nil {
+		return err
+	}
+	if deployedClient == cfgtypes.ConsensusClient_Unknown {
+		fmt.Println("No validator client has been started yet, so there is no slashing protection history to export.")
+		return nil
+	}
+	network := cfg.StaderNode.Network.Value.(cfgtypes.Network)
+	tool, err := slashing.GetExportCommand(deployedClient, network)
+	if err != nil {
+		return err
+	}
+	pendingClient, _ := cfg.GetSelectedConsensusClient()
+
+	// Prompt for confirmation
+	fmt.Printf("This will export the slashing protection history of your %s validator client to %s.\n", deployedClient, targetFile)
+	fmt.Println("Your validator client will be stopped during the export, so the history is complete.")
+	if pendingClient == deployedClient {
+		fmt.Printf("Once the export is complete, it will restart automatically.\n\n")
+	} else {
+		fmt.Printf("%sAs you are switching to %s, your %s validator client will stay stopped after the export.%s\n\n", colorYellow, pendingClient, deployedClient, colorReset)
+	}
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to export your slashing protection history?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Stop the validator client, making sure it isn't left stopped by a failed export
+	stopped, err := stopValidatorContainer(staderClient, validatorContainerName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil && stopped {
+			recoverValidatorContainer(staderClient, validatorContainerName, deployedClient, pendingClient == deployedClient)
+		}
+	}()
+
+	// Export the history
+	validatorsDir, err := homedir.Expand(cfg.StaderNode.GetValidatorKeychainPathInCLI())
+	if err != nil {
+		return fmt.Errorf("Error expanding validators folder path: %w", err)
+	}
+	fmt.Printf("Exporting the %s slashing protection history...\n", deployedClient)
+	err = staderClient.RunSlashingProtectionExport(prefix+SlashingProtectionContainerSuffix, getSlashingProtectionToolImage(cfg, deployedClient, deployedImage), validatorsDir, tool, targetFile)
+	if err != nil {
+		return fmt.Errorf("Error exporting slashing protection history: %w", err)
+	}
+
+	// Check the export
+	interchange, err := slashing.LoadInterchange(targetFile)
+	if err != nil {
+		return err
+	}
+	genesisValidatorsRoot, _ := eth2.GetGenesisValidatorsRoot(network)
+	if err := interchange.Validate(genesisValidatorsRoot); err != nil {
+		return fmt.Errorf("The exported slashing protection history in %s is invalid: %w", targetFile, err)
+	}
+
+	// Restart the validator client if it isn't being replaced
+	if stopped && pendingClient == deployedClient {
+		if err := startValidatorContainer(staderClient, validatorContainerName); err != nil {
+			return err
+		}
+		stopped = false
+	}
+
+	fmt.Printf("\nDone! Exported the slashing protection history of %d validator(s) to %s.\n", len(interchange.Data), targetFile)
+	if pendingClient != deployedClient {
+		fmt.Printf("Import it into %s with `stader-cli service import-slashing-protection %s` before starting the service.\n", pendingClient, targetFile)
+	}
+	return nil
+
+}
+
+// Merge EIP-3076 interchange files and import them into the configured validator client
+func importSlashingProtection(c *cli.Context, sourceFiles []string) (err error) {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Get the config
+	cfg, err := loadSlashingProtectionConfig(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Load, validate and merge the files
+	network := cfg.StaderNode.Network.Value.(cfgtypes.Network)
+	genesisValidatorsRoot, _ := eth2.GetGenesisValidatorsRoot(network)
+	interchanges := make([]*slashing.Interchange, len(sourceFiles))
+	for i, sourceFile := range sourceFiles {
+		interchange, err := slashing.LoadInterchange(sourceFile)
+		if err != nil {
+			return err
+		}
+		if err := interchange.Validate(genesisValidatorsRoot); err != nil {
+			return fmt.Errorf("Slashing protection file %s is invalid: %w", sourceFile, err)
+		}
+		interchanges[i] = interchange
+	}
+	merged, err := slashing.MergeInterchanges(interchanges...)
+	if err != nil {
+		return err
+	}
+	blocks, attestations := 0, 0
+	for _, history := range merged.Data {
+		blocks += len(history.SignedBlocks)
+		attestations += len(history.SignedAttestations)
+	}
+
+	// Get the validator client to import into
+	pendingClient, _ := cfg.GetSelectedConsensusClient()
+	selectedConsensusClientConfig, err := cfg.GetSelectedConsensusClientConfig()
+	if err != nil {
+		return fmt.Errorf("Error getting selected consensus client config: %w", err)
+	}
+	tool, err := slashing.GetImportCommand(pendingClient, network)
+	if err != nil {
+		return err
+	}
+	prefix, err := getContainerPrefix(staderClient)
+	if err != nil {
+		return fmt.Errorf("Error getting container prefix: %w", err)
+	}
+	validatorContainerName := prefix + ValidatorContainerSuffix
+	deployedClient, _, err := getDeployedValidatorClient(staderClient, validatorContainerName)
+	if err != nil {
+		return err
+	}
+
+	// Prompt for confirmation
+	fmt.Printf("This will import the slashing protection history of %d validator(s) (%d signed blocks and %d signed attestations) into your %s validator client.\n", len(merged.Data), blocks, attestations, pendingClient)
+	fmt.Printf("Your validator client will be stopped during the import.\n\n")
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to import this slashing protection history?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Stop the validator client, making sure it isn't left stopped by a failed import
+	stopped := false
+	if deployedClient != cfgtypes.ConsensusClient_Unknown {
+		stopped, err = stopValidatorContainer(staderClient, validatorContainerName)
+		if err != nil {
+			return err
+		}
+	}
+	defer func() {
+		if err != nil && stopped {
+			recoverValidatorContainer(staderClient, validatorContainerName, deployedClient, deployedClient == pendingClient)
+		}
+	}()
+
+	// Write the merged history where the tool container can read it
+	interchangeDir, err := ioutil.TempDir("", "slashing-protection")
+	if err != nil {
+		return fmt.Errorf("Error creating temporary folder: %w", err)
+	}
+	defer os.RemoveAll(interchangeDir)
+	if err := merged.Save(filepath.Join(interchangeDir, slashing.InterchangeFilename)); err != nil {
+		return err
+	}
+
+	// Import the history
+	validatorsDir, err := homedir.Expand(cfg.StaderNode.GetValidatorKeychainPathInCLI())
+	if err != nil {
+		return fmt.Errorf("Error expanding validators folder path: %w", err)
+	}
+	fmt.Printf("Importing the slashing protection history into %s...\n", pendingClient)
+	err = staderClient.RunSlashingProtectionImport(prefix+SlashingProtectionContainerSuffix, getSlashingProtectionToolImage(cfg, pendingClient, selectedConsensusClientConfig.GetValidatorImage()), validatorsDir, interchangeDir, tool)
+	if err != nil {
+		return fmt.Errorf("Error importing slashing protection history: %w", err)
+	}
+
+	// Record the import, so the service knows which validators the client protects
+	recordDir, err := homedir.Expand(cfg.StaderNode.GetSlashingProtectionPath(false))
+	if err != nil {
+		return fmt.Errorf("Error expanding slashing protection folder path: %w", err)
+	}
+	if err := slashing.SaveImportRecord(recordDir, pendingClient, merged); err != nil {
+		return err
+	}
+
+	// Restart the validator client if it was already the configured one
+	if stopped && deployedClient == pendingClient {
+		if err := startValidatorContainer(staderClient, validatorContainerName); err != nil {
+			return err
+		}
+		stopped = false
+	}
+
+	fmt.Printf("\nDone! Imported the slashing protection history into %s.\n", pendingClient)
+	if deployedClient != pendingClient {
+		fmt.Println("Run `stader-cli service start` to start your new validator client.")
+		return nil
+	}
+
+	// Load the imported keys that are now protected
+	heldKeys, err := hasHeldImportedKeys(cfg)
+	if err != nil {
+		return err
+	}
+	if heldKeys {
+		releaseImportedKeys(staderClient)
+	}
+	return nil
+
+}
+
+// Check that the validator client about to be started has the slashing protection history of its validators.
+// A new client needs the history of the client it replaces, and validator keys imported into the wallet need the history from wherever they validated before.
+// Imported keys are held out of the validator client until then, so only keys already loaded into it can block the start; ignoreImportedKeys skips that check.
+func checkSlashingProtection(staderClient *stader.Client, cfg *config.StaderConfig, ignoreImportedKeys bool) (bool, error) {
+
+	// Get the import record of the configured client
+	pendingClient, _ := cfg.GetSelectedConsensusClient()
+	recordDir, err := homedir.Expand(cfg.StaderNode.GetSlashingProtectionPath(false))
+	if err != nil {
+		return false, fmt.Errorf("Error expanding slashing protection folder path: %w", err)
+	}
+	record, err := slashing.LoadImportRecord(recordDir, pendingClient)
+	if err != nil {
+		return false, err
+	}
+
+	// Check for a client switch
+	prefix, err := getContainerPrefix(staderClient)
+	if err != nil {
+		return false, fmt.Errorf("Error getting container prefix: %w", err)
+	}
+	validatorContainerName := prefix + ValidatorContainerSuffix
+	deployedClient, _, err := getDeployedValidatorClient(staderClient, validatorContainerName)
+	if err != nil {
+		return false, err
+	}
+	if deployedClient != cfgtypes.ConsensusClient_Unknown && deployedClient != pendingClient {
+		status, err := staderClient.GetDockerStatus(validatorContainerName)
+		if err != nil {
+			return false, fmt.Errorf("Error getting container [%s] status: %w", validatorContainerName, err)
+		}
+		finishTime, err := staderClient.GetDockerContainerShutdownTime(validatorContainerName)
+		if err != nil {
+			return false, fmt.Errorf("Error getting validator shutdown time: %w", err)
+		}
+		if status == "running" || record == nil || record.ImportedAt.Before(finishTime) {
+			fmt.Printf("%sYou are switching your validator client from %s to %s, but its slashing protection history has not been moved yet.\n", colorRed, deployedClient, pendingClient)
+			fmt.Println("Without it, the new client may sign something your validators already signed, which will get them slashed!")
+			fmt.Printf("Move it first:%s\n", colorReset)
+			fmt.Println("\tstader-cli service export-slashing-protection slashing-protection.json")
+			fmt.Printf("\tstader-cli service import-slashing-protection slashing-protection.json\n\n")
+			return false, nil
+		}
+	}
+
+	// Check the validator keys imported into the wallet, read from disk so this works while the service is down
+	if ignoreImportedKeys {
+		fmt.Printf("%sIgnoring the slashing protection history of validator keys imported into the wallet.%s\n", colorYellow, colorReset)
+		return true, nil
+	}
+	importedKeys, err := getImportedKeys(cfg)
+	if err != nil {
+		return false, err
+	}
+	pubkeys := make([]types.ValidatorPubkey, len(importedKeys))
+	isHeld := map[types.ValidatorPubkey]bool{}
+	for i, key := range importedKeys {
+		pubkeys[i] = key.PublicKey
+		isHeld[key.PublicKey] = key.Held
+	}
+	unprotected, err := slashing.GetUnprotectedPubkeys(recordDir, pendingClient, pubkeys)
+	if err != nil {
+		return false, err
+	}
+	loaded, held := []types.ValidatorPubkey{}, []types.ValidatorPubkey{}
+	for _, pubkey := range unprotected {
+		if isHeld[pubkey] {
+			held = append(held, pubkey)
+		} else {
+			loaded = append(loaded, pubkey)
+		}
+	}
+
+	// Held keys can't sign, so they only need a reminder
+	if len(held) > 0 {
+		fmt.Printf("%sThe following imported validator keys are held out of %s until their slashing protection history is imported into it:\n", colorYellow, pendingClient)
+		for _, pubkey := range held {
+			fmt.Printf("\t0x%s\n", pubkey.Hex())
+		}
+		fmt.Printf("Import it with `stader-cli service import-slashing-protection`, or confirm keys that never validated with `stader-cli service confirm-no-slashing-history`.%s\n\n", colorReset)
+	}
+	if len(loaded) > 0 {
+		fmt.Printf("%sThe following validator keys were imported into the wallet, but their slashing protection history has not been imported into %s:\n", colorRed, pendingClient)
+		for _, pubkey := range loaded {
+			fmt.Printf("\t0x%s\n", pubkey.Hex())
+		}
+		fmt.Printf("%s\nExport the history from the client that used these keys before and import it with `stader-cli service import-slashing-protection`.\n", colorReset)
+		fmt.Printf("If you understand the risk and want to start anyway, run `stader-cli service start --ignore-slashing-protection`.\n\n")
+		return false, nil
+	}
+
+	return true, nil
+
+}
+
+// Confirm that imported validator keys have never signed a block or attestation, so they can be loaded into the validator client without slashing protection history
+func confirmNoSlashingHistory(c *cli.Context, pubkeys []types.ValidatorPubkey) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Get the config
+	cfg, err := loadSlashingProtectionConfig(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Only held imported keys need the confirmation
+	importedKeys, err := getImportedKeys(cfg)
+	if err != nil {
+		return err
+	}
+	isHeld := map[types.ValidatorPubkey]bool{}
+	for _, key := range importedKeys {
+		isHeld[key.PublicKey] = key.Held
+	}
+	for _, pubkey := range pubkeys {
+		if !isHeld[pubkey] {
+			return fmt.Errorf("Validator key 0x%s is not an imported key held out of the validator client.", pubkey.Hex())
+		}
+	}
+
+	// Confirm each key separately
+	fmt.Printf("%sOnly confirm a key if it has NEVER been used to validate, on any machine or service.\nA key that signed anything before and is loaded without its slashing protection history MAY GET YOUR VALIDATOR SLASHED.%s\n\n", colorRed, colorReset)
+	confirmed := []types.ValidatorPubkey{}
+	for _, pubkey := range pubkeys {
+		if cliutils.Confirm(fmt.Sprintf("Has validator key 0x%s never signed a block or attestation anywhere?", pubkey.Hex())) {
+			confirmed = append(confirmed, pubkey)
+		}
+	}
+	if len(confirmed) == 0 {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Record the confirmations
+	recordDir, err := homedir.Expand(cfg.StaderNode.GetSlashingProtectionPath(false))
+	if err != nil {
+		return fmt.Errorf("Error expanding slashing protection folder path: %w", err)
+	}
+	if err := slashing.AddNoHistoryPubkeys(recordDir, confirmed); err != nil {
+		return err
+	}
+	fmt.Printf("\nConfirmed %d validator key(s) have no slashing protection history.\n", len(confirmed))
+
+	// Load them into the validator client
+	releaseImportedKeys(staderClient)
+	return nil
+
+}
+
+// Load the held imported keys whose slashing protection history is in the validator client into it.
+// This restarts the validator client, so the keys are left for the next service start if it isn't running.
+func releaseImportedKeys(staderClient *stader.Client) {
+	prefix, err := getContainerPrefix(staderClient)
+	if err != nil {
+		fmt.Printf("%sCouldn't get the container prefix: %s%s\n", colorYellow, err.Error(), colorReset)
+		return
+	}
+	status, err := staderClient.GetDockerStatus(prefix + ValidatorContainerSuffix)
+	if err != nil || status != "running" {
+		fmt.Println("The imported validator keys will be loaded into your validator client the next time you run `stader-cli service start`.")
+		return
+	}
+
+	response, err := staderClient.ReleaseImportedKeys()
+	if err != nil {
+		fmt.Printf("%sCouldn't load the imported validator keys into your validator client: %s\nThey will be loaded the next time you run `stader-cli service start`.%s\n", colorYellow, err.Error(), colorReset)
+		return
+	}
+	if len(response.Released) > 0 {
+		fmt.Printf("Loaded %d imported validator key(s) into your validator client.\n", len(response.Released))
+	}
+	if response.RestartedValidator {
+		fmt.Println("Your validator client was restarted to load them.")
+	}
+}
+
+// Get the validator keys imported into the node wallet
+func getImportedKeys(cfg *config.StaderConfig) ([]wallet.ImportedValidatorKey, error) {
+	walletDir, err := homedir.Expand(cfg.StaderNode.GetWalletFolderInCLI())
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding wallet folder path: %w", err)
+	}
+	return wallet.LoadImportedValidatorKeys(walletDir)
+}
+
+// Check if any imported validator keys are held out of the validator client
+func hasHeldImportedKeys(cfg *config.StaderConfig) (bool, error) {
+	importedKeys, err := getImportedKeys(cfg)
+	if err != nil {
+		return false, err
+	}
+	for _, key := range importedKeys {
+		if key.Held {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Load the config for moving slashing protection history, which is only managed for Docker validator clients
+func loadSlashingProtectionConfig(staderClient *stader.Client) (*config.StaderConfig, error) {
+	cfg, isNew, err := staderClient.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+	if isNew {
+		return nil, fmt.Errorf("Settings file not found. Please run `stader-cli service config` to set up your Stadernode.")
+	}
+	if cfg.IsNativeMode {
+		return nil, fmt.Errorf("In Native mode, use your validator client's own tools to export and import its slashing protection history.")
+	}
+	return cfg, nil
+}
+
+// Get the validator client of the deployed validator container; returns an unknown client if it hasn't been created yet
+func getDeployedValidatorClient(staderClient *stader.Client, validatorContainerName string) (cfgtypes.ConsensusClient, string, error) {
+	image, err := staderClient.GetDockerImage(validatorContainerName)
+	if err != nil || image == "" {
+		return cfgtypes.ConsensusClient_Unknown, "", nil
+	}
+	client, err := slashing.GetClientFromImage(image)
+	if err != nil {
+		return cfgtypes.ConsensusClient_Unknown, "", err
+	}
+	return client, image, nil
+}
+
+// Get the image with a validator client's slashing protection tool.
+// Nimbus only ships it in the Beacon node image.
+func getSlashingProtectionToolImage(cfg *config.StaderConfig, client cfgtypes.ConsensusClient, validatorImage string) string {
+	if client == cfgtypes.ConsensusClient_Nimbus {
+		return cfg.Nimbus.BnContainerTag.Value.(string)
+	}
+	return validatorImage
+}
+
+// Stop the validator container if it is running, returning whether it was
+func stopValidatorContainer(staderClient *stader.Client, validatorContainerName string) (bool, error) {
+	status, err := staderClient.GetDockerStatus(validatorContainerName)
+	if err != nil {
+		return false, fmt.Errorf("Error getting container [%s] status: %w", validatorContainerName, err)
+	}
+	if status != "running" {
+		return false, nil
+	}
+
+	fmt.Printf("Stopping %s...\n", validatorContainerName)
+	result, err := staderClient.StopContainer(validatorContainerName)
+	if err != nil {
+		return false, fmt.Errorf("Error stopping validator container: %w", err)
+	}
+	if result != validatorContainerName {
+		return false, fmt.Errorf("Unexpected output while stopping validator container: %s", result)
+	}
+	return true, nil
+}
+
+// Deal with a validator container stopped for an export or import that failed.
+// A client that isn't being replaced still has its own slashing protection database, so it is restarted; otherwise the user is told it was left stopped.
+func recoverValidatorContainer(staderClient *stader.Client, validatorContainerName string, client cfgtypes.ConsensusClient, restart bool) {
+	if restart {
+		err := startValidatorContainer(staderClient, validatorContainerName)
+		if err == nil {
+			return
+		}
+		fmt.Printf("%sCouldn't restart your validator client: %s%s\n", colorRed, err.Error(), colorReset)
+		fmt.Printf("%sYour %s validator client was left stopped, so your validators are offline. Restart it with `docker start %s`.%s\n", colorRed, client, validatorContainerName, colorReset)
+		return
+	}
+	fmt.Printf("%sYour %s validator client was left stopped, so your validators are offline until you move the slashing protection history and run `stader-cli service start`.%s\n", colorRed, client, colorReset)
+}
+
+// Start the validator container again
+func startValidatorContainer(staderClient *stader.Client, validatorContainerName string) error {
+	fmt.Printf("Restarting %s...\n", validatorContainerName)
+	result, err := staderClient.StartContainer(validatorContainerName)
+	if err != nil {
+		return fmt.Errorf("Error starting validator container: %w", err)
+	}
+	if result != validatorContainerName {
+		return fmt.Errorf("Unexpected output while starting validator container: %s", result)
+	}
+	return nil
+}
@@ -43,6 +43,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "yes, y",
 						Usage: "Automatically confirm deposit",
 					},
+					cli.StringFlag{
+						Name:  "unsigned-out",
+						Usage: "Write the unsigned transaction to this file instead of sending it, so it can be signed offline with `stader-cli wallet sign-tx`",
+					},
 					cli.Uint64Flag{
 						Name:  "num-validators, nv",
 						Usage: "Number of validators you want to create (Required)",
@@ -96,12 +100,21 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Name:      "send-cl-rewards",
 				Aliases:   []string{"wcr"},
 				Usage:     "Send all Consensus Layer rewards to the operator claim vault",
-				UsageText: "stader-cli validator send-cl-rewards --validator-pub-key",
+				UsageText: "stader-cli validator send-cl-rewards [--validator-pub-key | --all [--min-rewards]]",
 				Flags: []cli.Flag{
 					cli.StringFlag{
 						Name:  "validator-pub-key, vpk",
 						Usage: "Public key of the validator whose CL rewards we want to send to operator claim vault",
 					},
+					cli.BoolFlag{
+						Name:  "all, a",
+						Usage: "Send the CL rewards of every validator whose withdraw vault holds enough rewards",
+					},
+					cli.Float64Flag{
+						Name:  "min-rewards, m",
+						Usage: "With --all, the minimum operator share (in ETH) a withdraw vault must hold to be worth the gas",
+						Value: 0.01,
+					},
 					cli.BoolFlag{
 						Name:  "yes, y",
 						Usage: "Automatically confirm CL rewards send",
@@ -109,6 +122,11 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 				Action: func(c *cli.Context) error {
 
+					if c.Bool("all") {
+						// Run
+						return SendAllClRewards(c, c.Float64("min-rewards"))
+					}
+
 					validatorPubKey, err := cliutils.ValidatePubkey("validator-pub-key", c.String("validator-pub-key"))
 					if err != nil {
 						return err
@@ -129,6 +147,61 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					return getValidatorStatus(c)
 				},
 			},
+			{
+				Name:      "presign-status",
+				Aliases:   []string{"ps"},
+				Usage:     "Check which validators have a presigned exit message registered with Stader",
+				UsageText: "stader-cli validator presign-status",
+				Flags:     []cli.Flag{},
+				Action: func(c *cli.Context) error {
+
+					// Run
+					return getPresignStatus(c)
+				},
+			},
+			{
+				Name:      "verify-exits",
+				Aliases:   []string{"ve"},
+				Usage:     "Verify the presigned exit messages kept by the node daemon against the validator keys and the network's fork schedule",
+				UsageText: "stader-cli validator verify-exits",
+				Flags:     []cli.Flag{},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return verifyExits(c)
+				},
+			},
+			{
+				Name:      "performance",
+				Aliases:   []string{"pf"},
+				Usage:     "Show the attestation, proposal and sync committee performance of the node's validators over an epoch range",
+				UsageText: "stader-cli validator performance [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "start-epoch, s",
+						Usage: "First epoch of the range, defaults to about a day before the end epoch",
+					},
+					cli.Uint64Flag{
+						Name:  "end-epoch, e",
+						Usage: "Last epoch of the range, defaults to the latest settled epoch",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getValidatorPerformance(c)
+				},
+			},
 			{
 				Name:      "export",
 				Aliases:   []string{"e"},
@@ -95,10 +95,15 @@ func nodeDeposit(c *cli.Context) error {
 	}
 
 	// Make deposit
+	staderClient.SetUnsignedTx(c.String("unsigned-out") != "")
 	response, err := staderClient.NodeDeposit(baseAmount, big.NewInt(int64(numValidators)), true)
 	if err != nil {
 		return err
 	}
+	if response.UnsignedTx != nil {
+		fmt.Printf("The keys of the %d new validators have been saved to the node wallet; they will be created once the deposit is broadcast.\n", numValidators)
+		return cliutils.SaveUnsignedTx(c.String("unsigned-out"), response.UnsignedTx)
+	}
 
 	fmt.Printf("Creating %d validators...\n", numValidators)
 	cliutils.PrintTransactionHash(staderClient, response.TxHash)
@@ -0,0 +1,82 @@
+package validator
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"text/tabwriter"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+	"github.com/urfave/cli"
+)
+
+func getValidatorPerformance(c *cli.Context) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Print what network we're on
+	err = cliutils.PrintNetwork(staderClient)
+	if err != nil {
+		return err
+	}
+
+	response, err := staderClient.ValidatorPerformance(c.Uint64("start-epoch"), c.Uint64("end-epoch"))
+	if err != nil {
+		return err
+	}
+
+	if len(response.Validators) == 0 {
+		fmt.Printf("The node has no validators on the Beacon chain. Please use the %sstader-cli validator deposit%s command to register a validator with Stader\n\n", log.ColorGreen, log.ColorReset)
+		return nil
+	}
+
+	fmt.Printf("%s=== Validator Performance for Epochs %d to %d ===%s\n\n", log.ColorGreen, response.StartEpoch, response.EndEpoch, log.ColorReset)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Validator Pub Key\tIndex\tEffectiveness\tAttestations\tMissed Source\tMissed Target\tMissed Head\tProposals\tMissed Proposals\tSync Slots\tMissed Sync\tRewards (ETH)\t")
+	totalMissedSources := uint64(0)
+	for _, validator := range response.Validators {
+		totalMissedSources += validator.MissedSources
+		rewardsGwei := validator.AttestationRewards + int64(validator.ProposalRewards) + validator.SyncCommitteeRewards
+		fmt.Fprintf(tw, "%s\t%d\t%.2f%%\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%.6f\t\n",
+			validator.Pubkey.String(),
+			validator.Index,
+			validator.AttestationEffectiveness,
+			validator.AttestationDuties,
+			validator.MissedSources,
+			validator.MissedTargets,
+			validator.MissedHeads,
+			validator.ProposalsScheduled,
+			validator.ProposalsMissed,
+			validator.SyncCommitteeDuties,
+			validator.SyncCommitteeMissed,
+			float64(rewardsGwei)/1e9)
+	}
+	tw.Flush()
+	fmt.Println()
+
+	if totalMissedSources == 0 {
+		fmt.Printf("No missed attestations in this range.\n\n")
+		return nil
+	}
+
+	penalty := new(big.Int).Mul(response.MissedAttestationPenaltyPerStrike, new(big.Int).SetUint64(totalMissedSources))
+	fmt.Printf("%sWARNING: The node's validators missed %d attestation(s) in this range.%s\n", log.ColorYellow, totalMissedSources, log.ColorReset)
+	fmt.Printf("Stader charges %.6f ETH per missed attestation strike, up to %.6f ETH for these misses if the oracle counts each one as a strike.\n\n",
+		eth.WeiToEth(response.MissedAttestationPenaltyPerStrike), eth.WeiToEth(penalty))
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package validator
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/urfave/cli"
+)
+
+func getPresignStatus(c *cli.Context) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Print what network we're on
+	err = cliutils.PrintNetwork(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Get presign status
+	response, err := staderClient.PresignStatus()
+	if err != nil {
+		return err
+	}
+
+	if len(response.Validators) == 0 {
+		fmt.Printf("The node has no registered validators. Please use the %sstader-cli validator deposit%s command to register a validator with Stader\n\n", log.ColorGreen, log.ColorReset)
+		return nil
+	}
+
+	fmt.Printf("%s=== Presigned Exit Message Status ===%s\n\n", log.ColorGreen, log.ColorReset)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Validator Pub Key\tPresign Registered\tContract Status\tBeacon Status\t")
+	for _, validator := range response.Validators {
+		presignRegistered := "yes"
+		if !validator.PresignRegistered {
+			presignRegistered = "no"
+		}
+		beaconStatus := string(validator.BeaconStatus)
+		if !validator.ExistsOnBeaconChain {
+			beaconStatus = "not found"
+		}
+		pubKey := validator.Pubkey.String()
+		if validator.PresignMissing {
+			pubKey = "* " + pubKey
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t\n", pubKey, presignRegistered, validator.ContractStatusToDisplay, beaconStatus)
+	}
+	tw.Flush()
+	fmt.Println()
+
+	if response.MissingPresignCount == 0 {
+		fmt.Printf("All active validators have a presigned exit message registered with Stader.\n\n")
+		return nil
+	}
+
+	fmt.Printf("%sWARNING: %d active validator(s) marked with * have no presigned exit message registered with Stader.%s\n", log.ColorYellow, response.MissingPresignCount, log.ColorReset)
+	fmt.Println("Validators without a presigned exit message can be force exited or penalised by Stader.")
+	fmt.Printf("Make sure the %sstader node%s daemon is running and has access to the validator keys, then check its logs for presign errors.\n\n", log.ColorGreen, log.ColorReset)
+
+	return nil
+}
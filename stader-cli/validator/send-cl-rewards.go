@@ -2,10 +2,15 @@ package validator
 
 import (
 	"fmt"
+	"os"
+	"text/tabwriter"
+
 	"github.com/stader-labs/stader-node/shared/services/gas"
+	"github.com/stader-labs/stader-node/shared/types/api"
 
 	"github.com/stader-labs/stader-node/shared/services/stader"
 	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
 	"github.com/stader-labs/stader-node/shared/utils/math"
 	"github.com/stader-labs/stader-node/stader-lib/types"
 	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
@@ -79,3 +84,109 @@ func SendClRewards(c *cli.Context, validatorPubKey types.ValidatorPubkey) error
 
 	return nil
 }
+
+func SendAllClRewards(c *cli.Context, minRewards float64) error {
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Print what network we're on
+	err = cliutils.PrintNetwork(staderClient)
+	if err != nil {
+		return err
+	}
+
+	canSendAllClRewardsResponse, err := staderClient.CanSendAllClRewards(eth.EthToWei(minRewards))
+	if err != nil {
+		return err
+	}
+	if len(canSendAllClRewardsResponse.Vaults) == 0 {
+		fmt.Println("The node has no validators with a withdraw vault to send CL rewards from.")
+		return nil
+	}
+
+	// Print every vault and pick the eligible ones
+	eligibleVaults := []api.ClRewardsVault{}
+	failedVaults := []api.ClRewardsVault{}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Validator Pub Key\tWithdraw Vault\tOperator Share (ETH)\tState\t")
+	for _, vault := range canSendAllClRewardsResponse.Vaults {
+		fmt.Fprintf(tw, "%s\t%s\t%.6f\t%s\t\n", vault.Pubkey, vault.WithdrawVaultAddress.Hex(), math.RoundDown(eth.WeiToEth(vault.OperatorShare), 6), vault.State)
+		if vault.State != "eligible" {
+			continue
+		}
+		if vault.GasInfo.Simulation.Failed() {
+			failedVaults = append(failedVaults, vault)
+			continue
+		}
+		eligibleVaults = append(eligibleVaults, vault)
+	}
+	tw.Flush()
+	fmt.Println()
+
+	// Skip the vaults whose transaction would fail
+	for _, vault := range failedVaults {
+		fmt.Printf("%sSkipping validator %s, sending its CL rewards would fail: %s%s\n", log.ColorYellow, vault.Pubkey, vault.GasInfo.Simulation.Message, log.ColorReset)
+	}
+
+	if len(eligibleVaults) == 0 {
+		fmt.Printf("No withdraw vault holds between %.6f ETH and the rewards threshold for the operator.\n", minRewards)
+		return nil
+	}
+
+	// Use the highest gas limit of the eligible vaults
+	gasInfo := eligibleVaults[0].GasInfo
+	for _, vault := range eligibleVaults[1:] {
+		if vault.GasInfo.SafeGasLimit > gasInfo.SafeGasLimit {
+			gasInfo = vault.GasInfo
+		}
+	}
+	err = gas.AssignMaxFeeAndLimit(gasInfo, staderClient, c.Bool("yes"))
+	if err != nil {
+		return err
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf(
+		"Are you sure you want to send CL rewards for %d validators to the claim vault? This sends one transaction per validator.", len(eligibleVaults)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Send the rewards of each vault, carrying on past failures
+	results := make([]string, len(eligibleVaults))
+	for i, vault := range eligibleVaults {
+		res, err := staderClient.SendClRewards(vault.Pubkey)
+		if err != nil {
+			results[i] = fmt.Sprintf("failed: %s", err.Error())
+			continue
+		}
+
+		fmt.Printf("Sending %.6f CL Rewards of validator %s to Claim vault\n", math.RoundDown(eth.WeiToEth(res.ClRewardsAmount), 6), vault.Pubkey)
+		cliutils.PrintTransactionHash(staderClient, res.TxHash)
+		if _, err = staderClient.WaitForTransaction(res.TxHash); err != nil {
+			results[i] = fmt.Sprintf("failed: %s", err.Error())
+			continue
+		}
+		results[i] = fmt.Sprintf("sent in %s", res.TxHash.Hex())
+	}
+
+	fmt.Println()
+	tw = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Validator Pub Key\tOperator Share (ETH)\tResult\t")
+	for i, vault := range eligibleVaults {
+		fmt.Fprintf(tw, "%s\t%.6f\t%s\t\n", vault.Pubkey, math.RoundDown(eth.WeiToEth(vault.OperatorShare), 6), results[i])
+	}
+	tw.Flush()
+	fmt.Println()
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package validator
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/urfave/cli"
+)
+
+func verifyExits(c *cli.Context) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	response, err := staderClient.VerifyExits()
+	if err != nil {
+		return err
+	}
+
+	if len(response.Exits) == 0 {
+		if !response.StoreEnabled {
+			fmt.Printf("The node daemon does not keep a copy of the presigned exit messages. Enable `Store Presigned Exits` in the %sstader-cli service config%s Stadernode settings to keep one.\n\n", log.ColorGreen, log.ColorReset)
+		} else {
+			fmt.Printf("No presigned exit messages have been stored yet. They are stored the next time the node daemon presigns an exit.\n\n")
+		}
+		return nil
+	}
+
+	fmt.Printf("%s=== Stored Presigned Exit Messages (current epoch %d) ===%s\n\n", log.ColorGreen, response.CurrentEpoch, log.ColorReset)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Validator Pub Key\tIndex\tExit Epoch\tSigned With\tExpected\tStatus\t")
+	for _, exit := range response.Exits {
+		status := "usable"
+		if !exit.Usable {
+			status = "UNUSABLE"
+		}
+		signedWith := exit.SignedWithFork
+		if signedWith == "" {
+			signedWith = "invalid"
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\t%s\t\n", exit.Pubkey.String(), exit.ValidatorIndex, exit.Epoch, signedWith, exit.ExpectedFork, status)
+	}
+	tw.Flush()
+	fmt.Println()
+
+	if response.UnusableCount == 0 {
+		fmt.Printf("All %d stored presigned exit messages are valid for the current fork schedule.\n\n", len(response.Exits))
+		return nil
+	}
+
+	fmt.Printf("%sWARNING: %d stored presigned exit message(s) would be rejected by the Beacon chain:%s\n", log.ColorYellow, response.UnusableCount, log.ColorReset)
+	for _, exit := range response.Exits {
+		if exit.Usable {
+			continue
+		}
+		fmt.Printf("  %s:\n", exit.Pubkey.String())
+		for _, problem := range exit.Problems {
+			fmt.Printf("    - %s\n", problem)
+		}
+	}
+	fmt.Println()
+
+	return nil
+}
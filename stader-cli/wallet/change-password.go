@@ -0,0 +1,63 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+)
+
+func changePassword(c *cli.Context) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Get & check wallet status
+	status, err := staderClient.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Get the current & new passwords
+	currentPassword := c.String("current-password")
+	if currentPassword == "" {
+		currentPassword = cliutils.PromptPassword("Please enter the current wallet password:", "^.*$", "")
+	}
+	newPassword := c.String("new-password")
+	if newPassword == "" {
+		newPassword = promptPassword()
+	} else if _, err := cliutils.ValidateNodePassword("new wallet password", newPassword); err != nil {
+		return err
+	}
+
+	// Prompt for confirmation
+	fmt.Printf("%sThe node wallet and every validator keystore will be re-encrypted, and the validator client will be restarted to load them.\nYour validators will miss attestations while the validator client restarts.%s\n\n", log.ColorYellow, log.ColorReset)
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to change the wallet password?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Change the password
+	response, err := staderClient.ChangePassword(currentPassword, newPassword)
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Println("The wallet password was changed and the wallet and validator keystores were re-encrypted.")
+	if response.RestartedValidator {
+		fmt.Println("The validator client was restarted with the re-encrypted keystores.")
+	}
+	return nil
+
+}
@@ -147,6 +147,64 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "change-password",
+				Usage:     "Change the node wallet password, re-encrypting the wallet and every validator keystore",
+				UsageText: "stader-cli wallet change-password [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "current-password",
+						Usage: "The current wallet password",
+					},
+					cli.StringFlag{
+						Name:  "new-password",
+						Usage: "The new wallet password",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm the password change",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return changePassword(c)
+
+				},
+			},
+
+			{
+				Name:      "import-keystores",
+				Usage:     "Import validator keys from EIP-2335 keystores, such as those made by the staking deposit CLI. Imported keys can't be recovered from the wallet mnemonic.",
+				UsageText: "stader-cli wallet import-keystores [options] keystore-file-or-directory",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "password-file, p",
+						Usage: "A file containing the password the keystores were encrypted with",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm the import",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return importKeystores(c, c.Args().Get(0))
+
+				},
+			},
+
 			{
 				Name:      "export",
 				Aliases:   []string{"e"},
@@ -164,6 +222,33 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "sign-tx",
+				Usage:     "Sign an unsigned transaction saved by a node command with `--unsigned-out`, on the machine that holds the node account key",
+				UsageText: "stader-cli wallet sign-tx [options] unsigned-tx-file",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "out, o",
+						Usage: "The file to save the signed transaction to (defaults to the unsigned transaction file with a .signed.json extension)",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm signing the transaction",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return signTx(c, c.Args().Get(0))
+
+				},
+			},
 		},
 	})
 }
@@ -0,0 +1,122 @@
+package wallet
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+)
+
+func importKeystores(c *cli.Context, path string) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Get & check wallet status
+	status, err := staderClient.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Read the keystores
+	files, err := getKeystoreFiles(path)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fmt.Printf("No keystore files were found in %s.\n", path)
+		return nil
+	}
+	keystores := make([]string, len(files))
+	for i, file := range files {
+		bytes, err := ioutil.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("error reading keystore %s: %w", file, err)
+		}
+		keystores[i] = string(bytes)
+	}
+
+	// Get the keystore password
+	var password string
+	if c.String("password-file") != "" {
+		bytes, err := ioutil.ReadFile(c.String("password-file"))
+		if err != nil {
+			return fmt.Errorf("error reading keystore password file: %w", err)
+		}
+		password = strings.TrimRight(string(bytes), "\r\n")
+	} else {
+		password = cliutils.PromptPassword("Please enter the password the keystores were encrypted with:", "^.*$", "")
+	}
+
+	// Prompt for confirmation
+	fmt.Printf("%sWARNING:\nImported validator keys are NOT derived from your node wallet's mnemonic and CAN'T be recovered with it.\nKeep a backup of the original keystores and their password; if you lose them and this machine, you lose these validators.\n\nIf these keys are currently used for validation by another machine or service, you MUST STOP IT and make sure it will NEVER validate with them again before importing.\nRunning the same keys in two places at the same time WILL RESULT IN YOUR VALIDATORS BEING SLASHED.%s\n\n", log.ColorRed, log.ColorReset)
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to import %d validator key(s) into the node wallet?", len(keystores)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Import the keystores
+	response, err := staderClient.ImportKeystores(password, keystores)
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	imported := 0
+	for i, keystore := range response.Keystores {
+		if keystore.Imported {
+			fmt.Printf("Imported validator key %s from %s.\n", keystore.Pubkey.Hex(), files[i])
+			imported++
+		} else {
+			fmt.Printf("%sCould not import %s: %s%s\n", log.ColorYellow, files[i], keystore.Error, log.ColorReset)
+		}
+	}
+	fmt.Println()
+	fmt.Printf("Imported %d of %d validator key(s).\n", imported, len(keystores))
+	if response.RestartedValidator {
+		fmt.Println("Your validator client was restarted to load the imported keys.")
+	}
+	if len(response.MissingSlashingProtection) > 0 {
+		fmt.Printf("\n%sThese keys are held out of your validator client, because their slashing protection history hasn't been imported into it:\n", log.ColorYellow)
+		for _, pubkey := range response.MissingSlashingProtection {
+			fmt.Printf("\t0x%s\n", pubkey.Hex())
+		}
+		fmt.Println("Export their history from the client that used them before, then import it with `stader-cli service import-slashing-protection`.")
+		fmt.Printf("If a key has never been used to validate, confirm it with `stader-cli service confirm-no-slashing-history <pubkey>` instead.%s\n", log.ColorReset)
+	}
+	return nil
+
+}
+
+// Get the keystore files at a path; a directory is searched for keystore-*.json files, as written by the staking deposit CLI
+func getKeystoreFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", path, err)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(path, "keystore*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("error enumerating keystores in %s: %w", path, err)
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,99 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	"github.com/stader-labs/stader-node/shared/services/txs"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/math"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+func signTx(c *cli.Context, path string) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Get & check wallet status
+	status, err := staderClient.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Read the unsigned transaction
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read unsigned transaction from %s: %w", path, err)
+	}
+	var unsignedTx txs.UnsignedTransaction
+	if err := json.Unmarshal(bytes, &unsignedTx); err != nil {
+		return fmt.Errorf("could not decode unsigned transaction: %w", err)
+	}
+	tx, err := unsignedTx.ToTransaction()
+	if err != nil {
+		return err
+	}
+
+	// Print the transaction
+	fmt.Println("Transaction to sign:")
+	if unsignedTx.Command != "" {
+		fmt.Printf("\tBuilt by:     %s\n", unsignedTx.Command)
+	}
+	fmt.Printf("\tChain ID:     %s\n", tx.ChainId().String())
+	fmt.Printf("\tFrom:         %s\n", unsignedTx.From.Hex())
+	if tx.To() != nil {
+		fmt.Printf("\tTo:           %s\n", tx.To().Hex())
+	} else {
+		fmt.Println("\tTo:           (contract creation)")
+	}
+	fmt.Printf("\tValue:        %.6f ETH\n", math.RoundDown(eth.WeiToEth(tx.Value()), 6))
+	fmt.Printf("\tNonce:        %d\n", tx.Nonce())
+	fmt.Printf("\tGas limit:    %d\n", tx.Gas())
+	fmt.Printf("\tMax fee:      %.6f Gwei\n", eth.WeiToGwei(tx.GasFeeCap()))
+	fmt.Printf("\tPriority fee: %.6f Gwei\n", eth.WeiToGwei(tx.GasTipCap()))
+	fmt.Printf("\tData:         %d bytes\n\n", len(tx.Data()))
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to sign this transaction with the node account?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Sign it
+	response, err := staderClient.SignTx(&unsignedTx)
+	if err != nil {
+		return err
+	}
+
+	// Save the signed transaction
+	outPath := c.String("out")
+	if outPath == "" {
+		outPath = strings.TrimSuffix(path, ".json") + ".signed.json"
+	}
+	signedBytes, err := json.MarshalIndent(response.SignedTx, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode signed transaction: %w", err)
+	}
+	if err := os.WriteFile(outPath, signedBytes, 0644); err != nil {
+		return fmt.Errorf("could not save signed transaction to %s: %w", outPath, err)
+	}
+
+	// Log & return
+	fmt.Printf("Signed transaction %s and saved it to %s.\n", response.SignedTx.Hash.Hex(), outPath)
+	fmt.Printf("Copy it back to the node and send it with `stader-cli node broadcast %s`.\n", outPath)
+	return nil
+
+}
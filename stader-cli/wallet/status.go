@@ -54,6 +54,12 @@ func getStatus(c *cli.Context) error {
 		fmt.Printf("Node account: %s\n", status.AccountAddress.Hex())
 		fmt.Printf("Current Nonce: %d\n", status.CurrentNonce)
 		fmt.Printf("Pending Nonce: %d\n", status.PendingNonce)
+		if len(status.ImportedKeys) > 0 {
+			fmt.Printf("\n%d validator key(s) were imported from keystores. They are NOT recoverable from the wallet mnemonic, keep backups of their keystores:\n", len(status.ImportedKeys))
+			for _, pubkey := range status.ImportedKeys {
+				fmt.Printf("\t%s\n", pubkey.Hex())
+			}
+		}
 	} else {
 		fmt.Println("The node wallet has not been initialized.")
 	}
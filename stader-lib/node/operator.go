@@ -11,6 +11,11 @@ import (
 	"math/big"
 )
 
+const (
+	validatorPageSize          = 100
+	validatorPagesPerMulticall = 5
+)
+
 func EstimateOnboardNodeOperator(pnr *stader.PermissionlessNodeRegistryContractManager, mevSocialize bool, operatorName string, operatorRewarderAddress common.Address, opts *bind.TransactOpts) (stader.GasInfo, error) {
 	return pnr.PermissionlessNodeRegistryContract.GetTransactionGasInfo(opts, "onboardNodeOperator", mevSocialize, operatorName, operatorRewarderAddress)
 }
@@ -119,21 +124,45 @@ func GetValidatorInfosByOperator(pnr *stader.PermissionlessNodeRegistryContractM
 }
 
 func GetAllValidatorsInfoByOperator(pnr *stader.PermissionlessNodeRegistryContractManager, operatorAddress common.Address, opts *bind.CallOpts) ([]contracts.Validator, error) {
-	finalValidators := []contracts.Validator{}
-	pageNumber := big.NewInt(1)
-	pageSize := big.NewInt(100)
+	// Read every page at the same block so validators can't shift between pages
+	opts, err := stader.PinCallOpts(pnr.Client, opts)
+	if err != nil {
+		return nil, err
+	}
 
-	for {
-		validators, err := pnr.PermissionlessNodeRegistry.GetValidatorsByOperator(opts, operatorAddress, pageNumber, pageSize)
+	operatorId, err := GetOperatorId(pnr, operatorAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+	totalKeys, err := GetTotalValidatorKeys(pnr, operatorId, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := big.NewInt(validatorPageSize)
+	pageCount := new(big.Int).Div(new(big.Int).Add(totalKeys, big.NewInt(validatorPageSize-1)), pageSize).Int64()
+
+	mc, err := stader.NewMultiCaller(pnr.Client)
+	if err != nil {
+		return nil, err
+	}
+	// Pages carry pubkeys and signatures, keep each aggregate call small
+	mc.ChunkSize = validatorPagesPerMulticall
+
+	pages := make([][]contracts.Validator, pageCount)
+	for i := int64(0); i < pageCount; i++ {
+		err := mc.AddCall(pnr.PermissionlessNodeRegistryContract, &pages[i], "getValidatorsByOperator", operatorAddress, big.NewInt(i+1), pageSize)
 		if err != nil {
 			return nil, err
 		}
-		if len(validators) == 0 {
-			break
-		}
+	}
+	if err := mc.Execute(opts); err != nil {
+		return nil, err
+	}
 
-		finalValidators = append(finalValidators, validators...)
-		pageNumber.Add(pageNumber, big.NewInt(1))
+	finalValidators := []contracts.Validator{}
+	for _, page := range pages {
+		finalValidators = append(finalValidators, page...)
 	}
 
 	return finalValidators, nil
@@ -104,6 +104,15 @@ func CalculateValidatorWithdrawVaultWithdrawShare(executionClient stader.Executi
 	return vwv.ValidatorWithdrawVault.CalculateValidatorWithdrawalShare(opts)
 }
 
+func AddCalculateValidatorWithdrawVaultWithdrawShareCall(mc *stader.MultiCaller, executionClient stader.ExecutionClient, validatorWithdrawVaultAddress common.Address, withdrawShare *types2.RewardShare) error {
+	vwv, err := stader.NewValidatorWithdrawVaultFactory(executionClient, validatorWithdrawVaultAddress)
+	if err != nil {
+		return err
+	}
+
+	return mc.AddCall(vwv.ValidatorWithdrawVaultContract, withdrawShare, "calculateValidatorWithdrawalShare")
+}
+
 func GetValidatorIdByPubKey(pnr *stader.PermissionlessNodeRegistryContractManager, validatorPubKey []byte, opts *bind.CallOpts) (*big.Int, error) {
 	return pnr.PermissionlessNodeRegistry.ValidatorIdByPubkey(opts, validatorPubKey)
 }
@@ -10,3 +10,11 @@ import (
 func GetCumulativeValidatorPenalty(pt *stader.PenaltyTrackerContractManager, validatorPubKey types.ValidatorPubkey, opts *bind.CallOpts) (*big.Int, error) {
 	return pt.Penalty.TotalPenaltyAmount(opts, validatorPubKey.Bytes())
 }
+
+func AddGetCumulativeValidatorPenaltyCall(mc *stader.MultiCaller, pt *stader.PenaltyTrackerContractManager, validatorPubKey types.ValidatorPubkey, penalty **big.Int) error {
+	return mc.AddCall(pt.PenaltyContract, penalty, "totalPenaltyAmount", validatorPubKey.Bytes())
+}
+
+func GetMissedAttestationPenaltyPerStrike(pt *stader.PenaltyTrackerContractManager, opts *bind.CallOpts) (*big.Int, error) {
+	return pt.Penalty.MissedAttestationPenaltyPerStrike(opts)
+}
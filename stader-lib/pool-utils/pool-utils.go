@@ -15,3 +15,7 @@ func CalculateRewardShare(pool_utils *stader.PoolUtilsContractManager, poolId ui
 func IsExistingOperator(pool_utils *stader.PoolUtilsContractManager, operatorAddress common.Address, opts *bind.CallOpts) (bool, error) {
 	return pool_utils.PoolUtils.IsExistingOperator(opts, operatorAddress)
 }
+
+func AddCalculateRewardShareCall(mc *stader.MultiCaller, pool_utils *stader.PoolUtilsContractManager, poolId uint8, totalRewards *big.Int, rewardShare *types.RewardShare) error {
+	return mc.AddCall(pool_utils.PoolUtilsContract, rewardShare, "calculateRewardShare", poolId, totalRewards)
+}
@@ -41,3 +41,16 @@ func IsSocializingPoolPaused(sp *stader.SocializingPoolContractManager, opts *bi
 func VerifyProof(sp *stader.SocializingPoolContractManager, operatorAddress common.Address, index *big.Int, amountSd *big.Int, amountEth *big.Int, merkleProof [][32]byte, opts *bind.CallOpts) (bool, error) {
 	return sp.SocializingPool.VerifyProof(opts, index, operatorAddress, amountSd, amountEth, merkleProof)
 }
+
+func GetRewardsMerkleRoot(sp *stader.SocializingPoolContractManager, index *big.Int, opts *bind.CallOpts) ([32]byte, error) {
+	rewardsData, err := sp.SocializingPool.RewardsDataMap(opts, index)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return rewardsData.MerkleRoot, nil
+}
+
+func AddHasClaimedRewardsCall(mc *stader.MultiCaller, sp *stader.SocializingPoolContractManager, address common.Address, index *big.Int, claimed *bool) error {
+	return mc.AddCall(sp.SocializingPoolContract, claimed, "claimedRewards", address, index)
+}
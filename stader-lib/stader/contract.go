@@ -50,8 +50,9 @@ type Contract struct {
 
 // Response for gas limits from network and from user request
 type GasInfo struct {
-	EstGasLimit  uint64 `json:"estGasLimit"`
-	SafeGasLimit uint64 `json:"safeGasLimit"`
+	EstGasLimit  uint64           `json:"estGasLimit"`
+	SafeGasLimit uint64           `json:"safeGasLimit"`
+	Simulation   SimulationResult `json:"simulation"`
 }
 
 // Call a contract method
@@ -72,6 +73,15 @@ func (c *Contract) GetTransactionGasInfo(opts *bind.TransactOpts, method string,
 		return response, fmt.Errorf("Error getting transaction gas info: Could not encode input data: %w", err)
 	}
 
+	// Simulate the transaction, stopping with the decoded reason if it would fail
+	response.Simulation, err = c.simulate(opts, input)
+	if err != nil {
+		return response, fmt.Errorf("Error getting transaction gas info: %w", err)
+	}
+	if response.Simulation.Failed() {
+		return response, &SimulationError{Simulation: response.Simulation}
+	}
+
 	// Estimate gas limit
 	estGasLimit, safeGasLimit, err := c.estimateGasLimit(opts, input)
 
@@ -116,6 +126,16 @@ func (c *Contract) GetTransferGasInfo(opts *bind.TransactOpts) (GasInfo, error)
 
 	response := GasInfo{}
 
+	// Simulate the transfer, stopping with the decoded reason if it would fail
+	simulation, err := c.simulate(opts, []byte{})
+	if err != nil {
+		return response, fmt.Errorf("Error getting transfer gas info: %w", err)
+	}
+	response.Simulation = simulation
+	if simulation.Failed() {
+		return response, &SimulationError{Simulation: simulation}
+	}
+
 	// Estimate gas limit
 	estGasLimit, safeGasLimit, err := c.estimateGasLimit(opts, []byte{})
 	if err != nil {
@@ -149,6 +169,17 @@ func (c *Contract) Transfer(opts *bind.TransactOpts) (common.Hash, error) {
 
 }
 
+// Simulate a contract transaction against the pending state
+func (c *Contract) simulate(opts *bind.TransactOpts, input []byte) (SimulationResult, error) {
+	return SimulateCall(c.Client, ethereum.CallMsg{
+		From:     opts.From,
+		To:       c.Address,
+		GasPrice: big.NewInt(0), // use 0 gwei for simulation
+		Value:    opts.Value,
+		Data:     input,
+	}, c.ABI)
+}
+
 // Estimate the expected and safe gas limits for a contract transaction
 func (c *Contract) estimateGasLimit(opts *bind.TransactOpts, input []byte) (uint64, uint64, error) {
 
@@ -162,6 +193,10 @@ func (c *Contract) estimateGasLimit(opts *bind.TransactOpts, input []byte) (uint
 	})
 
 	if err != nil {
+		// Decode the reason if the transaction would revert
+		if simulation, simErr := c.simulate(opts, input); simErr == nil && simulation.Failed() {
+			return 0, 0, &SimulationError{Simulation: simulation}
+		}
 		return 0, 0, fmt.Errorf("Could not estimate gas needed: %w", err)
 	}
 
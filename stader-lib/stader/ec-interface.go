@@ -43,6 +43,9 @@ type ExecutionClient interface {
 	// input.
 	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
 
+	// PendingCallContract executes an Ethereum contract call against the pending state.
+	PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error)
+
 	/// ============================
 	/// ContractTransactor Functions
 	/// ============================
@@ -0,0 +1,287 @@
+package stader
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The canonical Multicall3 deployment address, used until another one is set with SetMulticall3Address
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+var (
+	multicall3Address     = Multicall3Address
+	multicall3AddressLock sync.RWMutex
+
+	// Whether Multicall3 has code at an address, checked once per address
+	multicall3Deployed sync.Map
+)
+
+// Set the Multicall3 address used by new multicallers. The zero address disables batching,
+// and the calls are then sent one at a time.
+func SetMulticall3Address(address common.Address) {
+	multicall3AddressLock.Lock()
+	defer multicall3AddressLock.Unlock()
+	multicall3Address = address
+}
+
+// Get the Multicall3 address used by new multicallers
+func GetMulticall3Address() common.Address {
+	multicall3AddressLock.RLock()
+	defer multicall3AddressLock.RUnlock()
+	return multicall3Address
+}
+
+// Number of calls sent in a single aggregate3 call
+const DefaultMulticallChunkSize = 100
+
+const multicall3Abi = `[
+	{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"},
+	{"inputs":[{"internalType":"address","name":"addr","type":"address"}],"name":"getEthBalance","outputs":[{"internalType":"uint256","name":"balance","type":"uint256"}],"stateMutability":"view","type":"function"}
+]`
+
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+type queuedCall struct {
+	target common.Address
+	method string
+	data   []byte
+	unpack func([]byte) error
+
+	// Runs the call on its own when Multicall3 isn't available
+	direct func(ctx context.Context, blockNumber *big.Int) error
+}
+
+// Batches contract reads into Multicall3 aggregate3 calls, all executed at the same block.
+// Where Multicall3 isn't deployed, the calls are sent one at a time at that block instead.
+type MultiCaller struct {
+	Client    ExecutionClient
+	Address   common.Address
+	ChunkSize int
+	abi       abi.ABI
+	calls     []queuedCall
+}
+
+func NewMultiCaller(client ExecutionClient) (*MultiCaller, error) {
+	multicallAbi, err := abi.JSON(strings.NewReader(multicall3Abi))
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultiCaller{
+		Client:    client,
+		Address:   GetMulticall3Address(),
+		ChunkSize: DefaultMulticallChunkSize,
+		abi:       multicallAbi,
+		calls:     []queuedCall{},
+	}, nil
+}
+
+// Queue a view call on a contract. The output is filled in once the batch is executed, it must be a pointer to
+// the method's return type, or to a struct with a field per return value if the method returns several values.
+func (mc *MultiCaller) AddCall(contract *Contract, output interface{}, method string, params ...interface{}) error {
+	data, err := contract.ABI.Pack(method, params...)
+	if err != nil {
+		return fmt.Errorf("could not encode %s call: %w", method, err)
+	}
+
+	contractAbi := contract.ABI
+	target := *contract.Address
+	unpack := func(returnData []byte) error {
+		return contractAbi.UnpackIntoInterface(output, method, returnData)
+	}
+	client := mc.Client
+	mc.calls = append(mc.calls, queuedCall{
+		target: target,
+		method: method,
+		data:   data,
+		unpack: unpack,
+		direct: func(ctx context.Context, blockNumber *big.Int) error {
+			returnData, err := client.CallContract(ctx, ethereum.CallMsg{To: &target, Data: data}, blockNumber)
+			if err != nil {
+				return fmt.Errorf("call to %s on %s failed: %w", method, target.Hex(), err)
+			}
+			return unpack(returnData)
+		},
+	})
+	return nil
+}
+
+// Queue a read of an address's ETH balance
+func (mc *MultiCaller) AddEthBalanceCall(address common.Address, output **big.Int) error {
+	data, err := mc.abi.Pack("getEthBalance", address)
+	if err != nil {
+		return fmt.Errorf("could not encode getEthBalance call: %w", err)
+	}
+
+	multicallAbi := mc.abi
+	client := mc.Client
+	mc.calls = append(mc.calls, queuedCall{
+		target: mc.Address,
+		method: "getEthBalance",
+		data:   data,
+		unpack: func(returnData []byte) error {
+			return multicallAbi.UnpackIntoInterface(output, "getEthBalance", returnData)
+		},
+		direct: func(ctx context.Context, blockNumber *big.Int) error {
+			balance, err := client.BalanceAt(ctx, address, blockNumber)
+			if err != nil {
+				return fmt.Errorf("could not get the balance of %s: %w", address.Hex(), err)
+			}
+			*output = balance
+			return nil
+		},
+	})
+	return nil
+}
+
+// Number of calls waiting to be executed
+func (mc *MultiCaller) Count() int {
+	return len(mc.calls)
+}
+
+// Execute the queued calls in chunks and fill in their outputs. Every chunk is run at the block of opts,
+// or at the latest block when opts doesn't set one. The queue is cleared whether or not the calls succeed.
+func (mc *MultiCaller) Execute(opts *bind.CallOpts) error {
+	calls := mc.calls
+	mc.calls = []queuedCall{}
+	if len(calls) == 0 {
+		return nil
+	}
+
+	opts, err := PinCallOpts(mc.Client, opts)
+	if err != nil {
+		return err
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// Fall back to sending the calls one at a time where Multicall3 isn't deployed
+	deployed, err := mc.isDeployed(ctx)
+	if err != nil {
+		return err
+	}
+	if !deployed {
+		for _, call := range calls {
+			if err := call.direct(ctx, opts.BlockNumber); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	chunkSize := mc.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultMulticallChunkSize
+	}
+
+	for start := 0; start < len(calls); start += chunkSize {
+		end := start + chunkSize
+		if end > len(calls) {
+			end = len(calls)
+		}
+		if err := mc.executeChunk(ctx, opts.BlockNumber, calls[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Check whether Multicall3 has code at the multicaller's address
+func (mc *MultiCaller) isDeployed(ctx context.Context) (bool, error) {
+	if mc.Address == (common.Address{}) {
+		return false, nil
+	}
+	if deployed, checked := multicall3Deployed.Load(mc.Address); checked {
+		return deployed.(bool), nil
+	}
+	code, err := mc.Client.CodeAt(ctx, mc.Address, nil)
+	if err != nil {
+		return false, fmt.Errorf("could not check for Multicall3 at %s: %w", mc.Address.Hex(), err)
+	}
+	deployed := len(code) > 0
+	multicall3Deployed.Store(mc.Address, deployed)
+	return deployed, nil
+}
+
+func (mc *MultiCaller) executeChunk(ctx context.Context, blockNumber *big.Int, calls []queuedCall) error {
+	aggregateCalls := make([]multicall3Call, len(calls))
+	for i, call := range calls {
+		aggregateCalls[i] = multicall3Call{
+			Target:       call.target,
+			AllowFailure: true,
+			CallData:     call.data,
+		}
+	}
+
+	input, err := mc.abi.Pack("aggregate3", aggregateCalls)
+	if err != nil {
+		return fmt.Errorf("could not encode multicall: %w", err)
+	}
+	output, err := mc.Client.CallContract(ctx, ethereum.CallMsg{To: &mc.Address, Data: input}, blockNumber)
+	if err != nil {
+		return fmt.Errorf("multicall of %d calls failed: %w", len(calls), err)
+	}
+
+	results := []multicall3Result{}
+	if err := mc.abi.UnpackIntoInterface(&results, "aggregate3", output); err != nil {
+		return fmt.Errorf("could not decode multicall response: %w", err)
+	}
+	if len(results) != len(calls) {
+		return fmt.Errorf("multicall returned %d results for %d calls", len(results), len(calls))
+	}
+
+	for i, result := range results {
+		if !result.Success {
+			return fmt.Errorf("multicall call to %s on %s reverted", calls[i].method, calls[i].target.Hex())
+		}
+		if err := calls[i].unpack(result.ReturnData); err != nil {
+			return fmt.Errorf("could not decode %s response from %s: %w", calls[i].method, calls[i].target.Hex(), err)
+		}
+	}
+
+	return nil
+}
+
+// Get call options pinned to a block, so a series of reads sees one consistent state.
+// Options that already name a block are returned as they are.
+func PinCallOpts(client ExecutionClient, opts *bind.CallOpts) (*bind.CallOpts, error) {
+	if opts != nil && opts.BlockNumber != nil {
+		return opts, nil
+	}
+
+	pinnedOpts := &bind.CallOpts{}
+	if opts != nil {
+		*pinnedOpts = *opts
+	}
+	ctx := pinnedOpts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	blockNumber, err := client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the latest block number: %w", err)
+	}
+	pinnedOpts.BlockNumber = big.NewInt(0).SetUint64(blockNumber)
+
+	return pinnedOpts, nil
+}
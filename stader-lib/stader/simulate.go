@@ -0,0 +1,316 @@
+package stader
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stader-labs/stader-node/stader-lib/contracts"
+)
+
+// Selectors of the errors built into Solidity
+var (
+	errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0} // Error(string)
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71} // Panic(uint256)
+)
+
+// Reasons for the Solidity panic codes
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler inserted panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on an empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to an uninitialized function",
+}
+
+// Readable messages for the custom errors a node operator is most likely to run into.
+// Custom errors that aren't listed here have their name turned into a sentence instead.
+var customErrorMessages = map[string]string{
+	"CallerNotOperator":                  "the node account is not a registered operator",
+	"CooldownNotComplete":                "the withdrawal cooldown period has not passed yet",
+	"InSufficientBalance":                "the contract balance is too low",
+	"InsufficientBalance":                "the balance is too low",
+	"InsufficientSDToWithdraw":           "the node does not have enough SD collateral to withdraw this amount",
+	"InvalidProof":                       "the Merkle proof of the rewards is invalid for this cycle",
+	"NameCrossedMaxLength":               "the operator name is too long",
+	"NotEnoughRewardToDistribute":        "there are not enough rewards to distribute",
+	"NotEnoughRewardToWithdraw":          "there are not enough rewards to withdraw",
+	"NotEnoughSDCollateral":              "the node does not have enough SD collateral",
+	"OperatorAlreadyOnBoardedInProtocol": "the node is already registered with Stader",
+	"OperatorIsDeactivate":               "the operator has been deactivated",
+	"OperatorIsNotOnboarded":             "the node is not registered with Stader",
+	"OperatorNotOnBoarded":               "the node is not registered with Stader",
+	"PubkeyAlreadyExist":                 "one of the validator keys has already been added",
+	"RewardAlreadyClaimed":               "the rewards of this cycle have already been claimed",
+	"UnsupportedOperationInSafeMode":     "the operation is not supported while the protocol is in safe mode",
+	"ValidatorSettled":                   "the validator's funds have already been settled",
+	"maxKeyLimitReached":                 "the node has reached the maximum number of validator keys",
+}
+
+// The custom errors of every contract in stader-lib/contracts, by selector
+var knownErrors map[string]abi.Error
+var knownErrorsOnce sync.Once
+
+// The result of simulating a transaction with eth_call against the pending state
+type SimulationResult struct {
+	Simulated  bool          `json:"simulated"`
+	Success    bool          `json:"success"`
+	Reverted   bool          `json:"reverted"`
+	ErrorName  string        `json:"errorName,omitempty"`
+	ErrorArgs  []string      `json:"errorArgs,omitempty"`
+	Message    string        `json:"message,omitempty"`
+	RevertData hexutil.Bytes `json:"revertData,omitempty"`
+}
+
+// Check if the transaction was simulated and would fail
+func (r SimulationResult) Failed() bool {
+	return r.Simulated && !r.Success
+}
+
+// Error returned when a simulated transaction would fail
+type SimulationError struct {
+	Simulation SimulationResult
+}
+
+func (e *SimulationError) Error() string {
+	if e.Simulation.Reverted {
+		return fmt.Sprintf("the transaction would revert: %s", e.Simulation.Message)
+	}
+	return fmt.Sprintf("the transaction would fail: %s", e.Simulation.Message)
+}
+
+// Check if an error comes from a simulated transaction that would fail
+func IsSimulationError(err error) bool {
+	var simErr *SimulationError
+	return errors.As(err, &simErr)
+}
+
+// Simulate a transaction with eth_call against the pending state.
+// Revert reasons are decoded with the contract ABI if provided, and with the ABIs of every Stader contract otherwise.
+// A transaction that would revert is a successful simulation; any other error, like a rate limit or an unsupported method,
+// means the call couldn't be made and is returned as is.
+func SimulateCall(client ExecutionClient, call ethereum.CallMsg, contractAbi *abi.ABI) (SimulationResult, error) {
+
+	_, err := client.PendingCallContract(context.Background(), call)
+	if err == nil {
+		return SimulationResult{
+			Simulated: true,
+			Success:   true,
+		}, nil
+	}
+	result := SimulationResult{
+		Simulated: true,
+		Reverted:  true,
+		Message:   err.Error(),
+	}
+
+	// Decode the revert data if there is any
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if revertData, ok := getRevertData(dataErr.ErrorData()); ok {
+			result.RevertData = revertData
+			decodeRevert(&result, contractAbi)
+			return result, nil
+		}
+	}
+
+	// Some execution clients only report the revert in the message
+	if strings.HasPrefix(err.Error(), "execution reverted") {
+		return result, nil
+	}
+	return SimulationResult{}, fmt.Errorf("could not simulate transaction: %w", err)
+
+}
+
+// Decode the revert data of a simulation into its error name, arguments and message
+func decodeRevert(result *SimulationResult, contractAbi *abi.ABI) {
+
+	data := result.RevertData
+	if len(data) < 4 {
+		result.Message = "execution reverted without a reason"
+		return
+	}
+	selector := data[:4]
+
+	// Error(string), used by require() and revert() with a message
+	if bytes.Equal(selector, errorSelector) {
+		result.ErrorName = "Error"
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			result.Message = fmt.Sprintf("execution reverted with an invalid reason (%s)", err.Error())
+			return
+		}
+		result.ErrorArgs = []string{reason}
+		result.Message = reason
+		return
+	}
+
+	// Panic(uint256), used by failed assertions and arithmetic errors
+	if bytes.Equal(selector, panicSelector) {
+		result.ErrorName = "Panic"
+		if len(data) < 36 {
+			result.Message = "panic with an invalid code"
+			return
+		}
+		code := new(big.Int).SetBytes(data[4:36])
+		result.ErrorArgs = []string{fmt.Sprintf("0x%x", code)}
+		reason, exists := panicReasons[code.Uint64()]
+		if !code.IsUint64() || !exists {
+			reason = "unknown panic"
+		}
+		result.Message = fmt.Sprintf("panic: %s (code 0x%x)", reason, code)
+		return
+	}
+
+	// Custom errors
+	abiError, exists := findCustomError(selector, contractAbi)
+	if !exists {
+		result.Message = fmt.Sprintf("execution reverted with unknown error %s", hexutil.Encode(selector))
+		return
+	}
+	result.ErrorName = abiError.Name
+	result.Message = getCustomErrorMessage(abiError.Name)
+	args, err := abiError.Inputs.Unpack(data[4:])
+	if err != nil {
+		return
+	}
+	details := []string{}
+	for i, arg := range args {
+		value := formatErrorArg(arg)
+		result.ErrorArgs = append(result.ErrorArgs, value)
+		if i < len(abiError.Inputs) && abiError.Inputs[i].Name != "" {
+			details = append(details, fmt.Sprintf("%s: %s", abiError.Inputs[i].Name, value))
+		} else {
+			details = append(details, value)
+		}
+	}
+	if len(details) > 0 {
+		result.Message = fmt.Sprintf("%s (%s)", result.Message, strings.Join(details, ", "))
+	}
+
+}
+
+// Find a custom error by selector, in the contract ABI first and then in every Stader contract
+func findCustomError(selector []byte, contractAbi *abi.ABI) (abi.Error, bool) {
+	if contractAbi != nil {
+		for _, abiError := range contractAbi.Errors {
+			if bytes.Equal(abiError.ID[:4], selector) {
+				return abiError, true
+			}
+		}
+	}
+	knownErrorsOnce.Do(loadKnownErrors)
+	abiError, exists := knownErrors[string(selector)]
+	return abiError, exists
+}
+
+// Collect the custom errors of every contract in stader-lib/contracts
+func loadKnownErrors() {
+	knownErrors = map[string]abi.Error{}
+	for _, metaData := range []*bind.MetaData{
+		contracts.Erc20MetaData,
+		contracts.NodeElRewardVaultMetaData,
+		contracts.OperatorRewardsCollectorMetaData,
+		contracts.PenaltyTrackerMetaData,
+		contracts.PermissionlessNodeRegistryMetaData,
+		contracts.PermissionlessPoolMetaData,
+		contracts.PoolUtilsMetaData,
+		contracts.SdCollateralMetaData,
+		contracts.SocializingPoolMetaData,
+		contracts.StaderConfigMetaData,
+		contracts.StakePoolManagerMetaData,
+		contracts.ValidatorWithdrawVaultMetaData,
+		contracts.VaultFactoryMetaData,
+		contracts.VaultProxyMetaData,
+	} {
+		contractAbi, err := metaData.GetAbi()
+		if err != nil {
+			continue
+		}
+		for _, abiError := range contractAbi.Errors {
+			knownErrors[string(abiError.ID[:4])] = abiError
+		}
+	}
+}
+
+// Get the readable message of a custom error
+func getCustomErrorMessage(name string) string {
+	if message, exists := customErrorMessages[name]; exists {
+		return message
+	}
+
+	// Split the name into lowercase words, keeping acronyms together
+	words := []string{}
+	for _, part := range strings.Split(name, "_") {
+		runes := []rune(part)
+		start := 0
+		for i := 1; i <= len(runes); i++ {
+			if i < len(runes) && !isWordStart(runes, i) {
+				continue
+			}
+			word := string(runes[start:i])
+			if len(runes[start:i]) == 1 || strings.ToUpper(word) != word {
+				word = strings.ToLower(word)
+			}
+			words = append(words, word)
+			start = i
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// Check if a rune of a camel case name starts a new word, like the B of "InvalidBond" or the T of "ETHTransfer"
+func isWordStart(runes []rune, i int) bool {
+	if !unicode.IsUpper(runes[i]) {
+		return false
+	}
+	if unicode.IsLower(runes[i-1]) {
+		return true
+	}
+	return i+1 < len(runes) && unicode.IsLower(runes[i+1])
+}
+
+// Format a decoded error argument for display
+func formatErrorArg(arg interface{}) string {
+	switch value := arg.(type) {
+	case common.Address:
+		return value.Hex()
+	case []byte:
+		return hexutil.Encode(value)
+	case [32]byte:
+		return hexutil.Encode(value[:])
+	case *big.Int:
+		return value.String()
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// Get the revert data from the data of a JSON-RPC error, which execution clients return as a hex string
+func getRevertData(errorData interface{}) ([]byte, bool) {
+	dataString, ok := errorData.(string)
+	if !ok {
+		return nil, false
+	}
+	data, err := hexutil.Decode(dataString)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
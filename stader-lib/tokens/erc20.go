@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stader-labs/stader-node/stader-lib/stader"
 )
 
@@ -51,13 +52,8 @@ func EstimateApproveGas(tokenContract *stader.Erc20TokenContractManager, spender
 }
 
 // Approve a token Allowance for a spender
-func Approve(tokenContract *stader.Erc20TokenContractManager, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	tx, err := tokenContract.Erc20Token.Approve(opts, spender, amount)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	return tx.Hash(), nil
+func Approve(tokenContract *stader.Erc20TokenContractManager, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (*types.Transaction, error) {
+	return tokenContract.Erc20Token.Approve(opts, spender, amount)
 }
 
 // Estimate the gas of TransferFrom
@@ -21,3 +21,7 @@ func GetEthBalance(client stader.ExecutionClient, address common.Address, opts *
 
 	return ethBalance, nil
 }
+
+func AddGetEthBalanceCall(mc *stader.MultiCaller, address common.Address, balance **big.Int) error {
+	return mc.AddEthBalanceCall(address, balance)
+}
@@ -35,6 +35,7 @@ func EstimateSendTransactionGas(client stader.ExecutionClient, toAddress common.
 
 	// User-defined settings
 	response := stader.GasInfo{}
+	var err error
 
 	// Set default value
 	value := opts.Value
@@ -42,13 +43,23 @@ func EstimateSendTransactionGas(client stader.ExecutionClient, toAddress common.
 		value = big.NewInt(0)
 	}
 
-	// Estimate gas limit
-	gasLimit, err := client.EstimateGas(context.Background(), ethereum.CallMsg{
+	// Simulate the transaction, stopping with the decoded reason if it would fail
+	call := ethereum.CallMsg{
 		From:     opts.From,
 		To:       &toAddress,
 		GasPrice: big.NewInt(0), // set to 0 for simulation
 		Value:    value,
-	})
+	}
+	response.Simulation, err = stader.SimulateCall(client, call, nil)
+	if err != nil {
+		return stader.GasInfo{}, err
+	}
+	if response.Simulation.Failed() {
+		return response, &stader.SimulationError{Simulation: response.Simulation}
+	}
+
+	// Estimate gas limit
+	gasLimit, err := client.EstimateGas(context.Background(), call)
 	if err != nil {
 		return stader.GasInfo{}, err
 	}
@@ -25,17 +25,32 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stader-labs/stader-node/stader-lib/stader"
 )
 
-// Wait for a transaction to get mined
+// Execution clients that keep a record of the transactions sent through them, so a transaction replaced by another one with the same nonce can be followed
+type TransactionTracker interface {
+	// Get the transaction that replaced a transaction and whether it cancelled it; returns false if it wasn't replaced
+	GetTransactionReplacement(hash common.Hash) (common.Hash, bool, bool)
+
+	// Record the receipt of a transaction
+	RecordTransactionReceipt(receipt *types.Receipt)
+
+	// Record that the nonce of a transaction was used by another transaction
+	RecordTransactionDropped(hash common.Hash)
+}
+
+// Wait for a transaction to get mined.
+// If the client tracks transactions, a transaction replaced by the node is followed to the transaction that replaced it.
 func WaitForTransaction(client stader.ExecutionClient, hash common.Hash) (*types.Receipt, error) {
 
+	tracker, _ := client.(TransactionTracker)
 	var tx *types.Transaction
 	var err error
+	cancelled := false
 
 	// Get the transaction from its hash, retrying for 30 sec if it wasn't found
 	for i := 0; i < 30; i++ {
@@ -43,9 +58,10 @@ func WaitForTransaction(client stader.ExecutionClient, hash common.Hash) (*types
 			return nil, fmt.Errorf("Transaction not found after 30 seconds.")
 		}
 
+		hash, cancelled = followReplacement(tracker, hash, cancelled)
 		tx, _, err = client.TransactionByHash(context.Background(), hash)
 		if err != nil {
-			if err.Error() == "not found" {
+			if errors.Is(err, ethereum.NotFound) {
 				time.Sleep(1 * time.Second)
 				continue
 			}
@@ -55,17 +71,64 @@ func WaitForTransaction(client stader.ExecutionClient, hash common.Hash) (*types
 		}
 	}
 
-	// Wait for transaction to be mined
-	txReceipt, err := bind.WaitMined(context.Background(), client, tx)
+	// Get the transaction sender; replacements have the same sender and nonce
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("Could not get transaction sender: %w", err)
 	}
 
-	// Check transaction status
-	if txReceipt.Status == 0 {
-		return txReceipt, errors.New("Transaction failed with status 0")
+	// Wait for transaction to be mined
+	for {
+
+		// Follow the transaction if it was replaced
+		hash, cancelled = followReplacement(tracker, hash, cancelled)
+
+		// Get the sender's latest nonce before the receipt, so a receipt missing after the nonce was used means the transaction was replaced
+		nonce, err := client.NonceAt(context.Background(), from, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check for the receipt
+		txReceipt, err := client.TransactionReceipt(context.Background(), hash)
+		if err == nil {
+			if tracker != nil {
+				tracker.RecordTransactionReceipt(txReceipt)
+			}
+			if cancelled {
+				return txReceipt, fmt.Errorf("Transaction was cancelled by transaction %s", hash.Hex())
+			}
+			if txReceipt.Status == 0 {
+				return txReceipt, errors.New("Transaction failed with status 0")
+			}
+			return txReceipt, nil
+		} else if !errors.Is(err, ethereum.NotFound) {
+			return nil, err
+		}
+
+		// Check if the nonce was used by a transaction the node didn't record
+		if nonce > tx.Nonce() {
+			if replacement, _ := followReplacement(tracker, hash, cancelled); replacement != hash {
+				continue
+			}
+			if tracker != nil {
+				tracker.RecordTransactionDropped(hash)
+			}
+			return nil, fmt.Errorf("Transaction %s was replaced by another transaction with the same nonce", hash.Hex())
+		}
+
+		time.Sleep(1 * time.Second)
 	}
+}
 
-	// Return
-	return txReceipt, nil
+// Get the transaction that replaced a transaction, or the transaction itself if it wasn't replaced, and whether it has been cancelled
+func followReplacement(tracker TransactionTracker, hash common.Hash, cancelled bool) (common.Hash, bool) {
+	if tracker == nil {
+		return hash, cancelled
+	}
+	replacement, replacementCancelled, replaced := tracker.GetTransactionReplacement(hash)
+	if !replaced {
+		return hash, cancelled
+	}
+	return replacement, cancelled || replacementCancelled
 }
@@ -0,0 +1,64 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/txs"
+	"github.com/stader-labs/stader-node/shared/types/api"
+)
+
+func broadcastTx(c *cli.Context, rawTxHex string) (*api.BroadcastTxResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BroadcastTxResponse{}
+
+	// Decode the signed transaction
+	rawTx, err := hexutil.Decode(rawTxHex)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode signed transaction: %w", err)
+	}
+	signedTx, tx, err := txs.NewSignedTransaction(rawTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check it was signed by the node account for this network
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if signedTx.From != nodeAccount.Address {
+		return nil, fmt.Errorf("The transaction was signed by account %s, but the node account is %s", signedTx.From.Hex(), nodeAccount.Address.Hex())
+	}
+	if tx.ChainId().Cmp(w.GetChainID()) != 0 {
+		return nil, fmt.Errorf("The transaction was signed for chain %s, but the node is configured for chain %s", tx.ChainId().String(), w.GetChainID().String())
+	}
+
+	// Send it
+	if err := ec.SendTransaction(context.Background(), tx); err != nil {
+		return nil, fmt.Errorf("could not send transaction: %w", err)
+	}
+	response.TxHash = tx.Hash()
+
+	// Return response
+	return &response, nil
+
+}
@@ -3,7 +3,9 @@ package node
 import (
 	"github.com/stader-labs/stader-node/shared/services"
 	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/eth1"
 	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
 	"github.com/urfave/cli"
 	"math/big"
 )
@@ -46,7 +48,7 @@ func CanClaimRewards(c *cli.Context) (*api.CanClaimRewards, error) {
 
 	// estimate gas
 	gasInfo, err := node.EstimateClaimOperatorRewards(orc, opts)
-	if err != nil {
+	if err != nil && !stader.IsSimulationError(err) {
 		return nil, err
 	}
 
@@ -104,6 +106,8 @@ func ClaimRewards(c *cli.Context) (*api.ClaimRewards, error) {
 		return nil, err
 	}
 
+	eth1.CheckForUnsignedTx(c, opts)
+
 	// estimate gas
 	tx, err := node.ClaimOperatorRewards(orc, opts)
 	if err != nil {
@@ -112,5 +116,10 @@ func ClaimRewards(c *cli.Context) (*api.ClaimRewards, error) {
 
 	response.TxHash = tx.Hash()
 
+	response.UnsignedTx, err = eth1.GetUnsignedTx(c, opts, tx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &response, nil
 }
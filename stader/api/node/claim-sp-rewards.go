@@ -4,8 +4,11 @@ import (
 	"github.com/stader-labs/stader-node/shared/services"
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/utils/eth1"
+	"github.com/stader-labs/stader-node/shared/utils/stader"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
 	string_utils "github.com/stader-labs/stader-node/shared/utils/string-utils"
 	socializing_pool "github.com/stader-labs/stader-node/stader-lib/socializing-pool"
+	staderlib "github.com/stader-labs/stader-node/stader-lib/stader"
 	"github.com/urfave/cli"
 	"math/big"
 )
@@ -88,40 +91,18 @@ func canClaimSpRewards(c *cli.Context) (*api.CanClaimSpRewardsResponse, error) {
 
 	response := api.CanClaimSpRewardsResponse{}
 
-	isPaused, err := socializing_pool.IsSocializingPoolPaused(sp, nil)
+	rewardCycles, err := stdr.GetSpRewardCycles(sp, nodeAccount.Address, nil)
 	if err != nil {
 		return nil, err
 	}
-	if isPaused {
+	if rewardCycles.SocializingPoolContractPaused {
 		response.SocializingPoolContractPaused = true
 		return &response, nil
 	}
 
-	claimedCycles := []*big.Int{}
-	unclaimedCycles := []*big.Int{}
-	cyclesToDownload := []*big.Int{}
-
-	rewardDetails, err := socializing_pool.GetRewardDetails(sp, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	for i := int64(1); i < rewardDetails.CurrentIndex.Int64(); i++ {
-		cycle := big.NewInt(i)
-		isClaimed, err := socializing_pool.HasClaimedRewards(sp, nodeAccount.Address, cycle, nil)
-		if err != nil {
-			return nil, err
-		}
-		if isClaimed {
-			claimedCycles = append(claimedCycles, cycle)
-		} else {
-			unclaimedCycles = append(unclaimedCycles, cycle)
-		}
-	}
-
-	response.ClaimedCycles = claimedCycles
-	response.UnclaimedCycles = unclaimedCycles
-	response.CyclesToDownload = cyclesToDownload
+	response.ClaimedCycles = rewardCycles.ClaimedCycles
+	response.UnclaimedCycles = rewardCycles.UnclaimedCycles
+	response.CyclesToDownload = []*big.Int{}
 
 	return &response, nil
 }
@@ -141,11 +122,22 @@ func estimateSpRewardsGas(c *cli.Context, stringifiedCycles string) (*api.Estima
 	}
 	response := api.EstimateClaimSpRewardsGasResponse{}
 
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
 	cycles, err := string_utils.DestringifyArray(stringifiedCycles)
 	if err != nil {
 		return nil, err
 	}
 
+	// make sure we don't spend gas on a claim that will revert
+	err = stader.VerifyCachedCycleMerkleProofs(cfg, sp, nodeAccount.Address, cycles)
+	if err != nil {
+		return nil, err
+	}
+
 	amountSd, amountEth, merkleProofs, err := cfg.StaderNode.GetClaimData(cycles)
 	if err != nil {
 		return nil, err
@@ -157,7 +149,7 @@ func estimateSpRewardsGas(c *cli.Context, stringifiedCycles string) (*api.Estima
 	}
 
 	gasInfo, err := socializing_pool.EstimateClaimRewards(sp, cycles, amountSd, amountEth, merkleProofs, opts)
-	if err != nil {
+	if err != nil && !staderlib.IsSimulationError(err) {
 		return nil, err
 	}
 
@@ -186,7 +178,19 @@ func claimSpRewards(c *cli.Context, stringifiedCycles string) (*api.ClaimSpRewar
 		return nil, err
 	}
 
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
 	response := api.ClaimSpRewardsResponse{}
+
+	// make sure we don't spend gas on a claim that will revert
+	err = stader.VerifyCachedCycleMerkleProofs(cfg, sp, nodeAccount.Address, cycles)
+	if err != nil {
+		return nil, err
+	}
+
 	amountSd, amountEth, merkleProofs, err := cfg.StaderNode.GetClaimData(cycles)
 	if err != nil {
 		return nil, err
@@ -197,6 +201,8 @@ func claimSpRewards(c *cli.Context, stringifiedCycles string) (*api.ClaimSpRewar
 		return nil, err
 	}
 
+	eth1.CheckForUnsignedTx(c, opts)
+
 	tx, err := socializing_pool.ClaimRewards(sp, cycles, amountSd, amountEth, merkleProofs, opts)
 	if err != nil {
 		return nil, err
@@ -204,5 +210,10 @@ func claimSpRewards(c *cli.Context, stringifiedCycles string) (*api.ClaimSpRewar
 
 	response.TxHash = tx.Hash()
 
+	response.UnsignedTx, err = eth1.GetUnsignedTx(c, opts, tx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &response, nil
 }
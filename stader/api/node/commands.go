@@ -20,6 +20,8 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package node
 
 import (
+	"fmt"
+
 	"github.com/urfave/cli"
 
 	"github.com/stader-labs/stader-node/shared/utils/api"
@@ -39,15 +41,28 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Aliases:   []string{"s"},
 				Usage:     "Get the node's status",
 				UsageText: "stader-cli api node status",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "block, b",
+						Usage: "Get the status as of this EL block",
+					},
+					cli.Uint64Flag{
+						Name:  "slot, s",
+						Usage: "Get the status as of this Beacon slot",
+					},
+				},
 				Action: func(c *cli.Context) error {
 
 					// Validate args
 					if err := cliutils.ValidateArgCount(c, 0); err != nil {
 						return err
 					}
+					if c.Uint64("block") != 0 && c.Uint64("slot") != 0 {
+						return fmt.Errorf("only one of --block and --slot can be set")
+					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.PrintResponse(getStatus(c, c.Uint64("block"), c.Uint64("slot")))
 					return nil
 
 				},
@@ -316,6 +331,152 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "list-transactions",
+				Usage:     "List the pending transactions sent by the node wallet",
+				UsageText: "stader-cli api node list-transactions",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getPendingTransactions(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "list-events",
+				Usage:     "List the latest contract events indexed for the node, 0 for all of them",
+				UsageText: "stader-cli api node list-events limit",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					limit, err := cliutils.ValidateUint("limit", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getEvents(c, limit))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "can-speed-up-transaction",
+				Usage:     "Check whether a pending transaction can be sent again with higher fees",
+				UsageText: "stader-cli api node can-speed-up-transaction tx-hash",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					hash, err := cliutils.ValidateTxHash("tx hash", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canReplaceTransaction(c, hash, false))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "speed-up-transaction",
+				Usage:     "Send a pending transaction again with higher fees",
+				UsageText: "stader-cli api node speed-up-transaction tx-hash",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					hash, err := cliutils.ValidateTxHash("tx hash", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(replaceTransaction(c, hash, false))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "can-cancel-transaction",
+				Usage:     "Check whether a pending transaction can be cancelled",
+				UsageText: "stader-cli api node can-cancel-transaction tx-hash",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					hash, err := cliutils.ValidateTxHash("tx hash", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canReplaceTransaction(c, hash, true))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "cancel-transaction",
+				Usage:     "Cancel a pending transaction by sending 0 ETH to the node account with its nonce",
+				UsageText: "stader-cli api node cancel-transaction tx-hash",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					hash, err := cliutils.ValidateTxHash("tx hash", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(replaceTransaction(c, hash, true))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "broadcast",
+				Usage:     "Send a transaction signed offline for the node account",
+				UsageText: "stader-cli api node broadcast raw-tx",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(broadcastTx(c, c.Args().Get(0)))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "get-contracts-info",
 				Usage:     "Get information about the deposit contract and stader contract on the current network",
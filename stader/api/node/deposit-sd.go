@@ -13,6 +13,7 @@ import (
 	"github.com/stader-labs/stader-node/shared/services"
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/utils/eth1"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
 )
 
 func canNodeDepositSd(c *cli.Context, amountWei *big.Int) (*api.CanNodeDepositSdResponse, error) {
@@ -59,7 +60,7 @@ func canNodeDepositSd(c *cli.Context, amountWei *big.Int) (*api.CanNodeDepositSd
 		return nil, err
 	}
 	gasInfo, err := sd_collateral.EstimateDepositSdAsCollateral(sdc, amountWei, opts)
-	if err != nil {
+	if err != nil && !stader.IsSimulationError(err) {
 		return nil, err
 	}
 	response.GasInfo = gasInfo
@@ -95,7 +96,7 @@ func getDepositSdApprovalGas(c *cli.Context, amountWei *big.Int) (*api.NodeDepos
 		return nil, err
 	}
 	gasInfo, err := tokens.EstimateApproveGas(sdt, *sdc.SdCollateralContract.Address, amountWei, opts)
-	if err != nil {
+	if err != nil && !stader.IsSimulationError(err) {
 		return nil, err
 	}
 	response.GasInfo = gasInfo
@@ -169,12 +170,18 @@ func approveSd(c *cli.Context, amountWei *big.Int) (*api.NodeDepositSdApproveRes
 	if err != nil {
 		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
 	}
-	hash, err := tokens.Approve(sdt, *sdc.SdCollateralContract.Address, amountWei, opts)
+	eth1.CheckForUnsignedTx(c, opts)
+	tx, err := tokens.Approve(sdt, *sdc.SdCollateralContract.Address, amountWei, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	response.ApproveTxHash = hash
+	response.ApproveTxHash = tx.Hash()
+
+	response.UnsignedTx, err = eth1.GetUnsignedTx(c, opts, tx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Return response
 	return &response, nil
@@ -225,6 +232,7 @@ func depositSdAsCollateral(c *cli.Context, amountWei *big.Int) (*api.NodeDeposit
 	if err != nil {
 		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
 	}
+	eth1.CheckForUnsignedTx(c, opts)
 	tx, err := sd_collateral.DepositSdAsCollateral(sdc, amountWei, opts)
 	if err != nil {
 		return nil, err
@@ -232,6 +240,11 @@ func depositSdAsCollateral(c *cli.Context, amountWei *big.Int) (*api.NodeDeposit
 
 	response.DepositTxHash = tx.Hash()
 
+	response.UnsignedTx, err = eth1.GetUnsignedTx(c, opts, tx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return response
 	return &response, nil
 
@@ -0,0 +1,71 @@
+package node
+
+import (
+	"errors"
+	"os"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/events"
+	"github.com/stader-labs/stader-node/shared/types/api"
+)
+
+func getEvents(c *cli.Context, limit uint64) (*api.NodeEventsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeEventsResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Open the index read-only, the daemon is the only writer
+	store, err := events.OpenStore(os.ExpandEnv(cfg.StaderNode.GetEventIndexPath(true)), true)
+	if errors.Is(err, events.ErrNoIndex) {
+		return &response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	// Get the progress and the latest events
+	cursor, exists, err := store.GetCursor(nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return &response, nil
+	}
+	nodeEvents, err := store.GetEvents(nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+	response.Indexed = true
+	response.Cursor = cursor
+	response.TotalEvents = len(nodeEvents)
+	if limit > 0 && uint64(len(nodeEvents)) > limit {
+		nodeEvents = nodeEvents[uint64(len(nodeEvents))-limit:]
+	}
+	response.Events = nodeEvents
+
+	// Return response
+	return &response, nil
+
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/utils/eth1"
 	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
 	"github.com/urfave/cli"
 )
 
@@ -80,7 +81,7 @@ func canRegisterNode(c *cli.Context, operatorName string, operatorRewardAddress
 	}
 
 	gasInfo, err := node.EstimateOnboardNodeOperator(pnr, socializeMev, operatorName, operatorRewardAddress, opts)
-	if err != nil {
+	if err != nil && !stader.IsSimulationError(err) {
 		return nil, err
 	}
 
@@ -113,6 +114,7 @@ func registerNode(c *cli.Context, operatorName string, operatorRewardAddress com
 	if err != nil {
 		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
 	}
+	eth1.CheckForUnsignedTx(c, opts)
 
 	// Register node
 	tx, err := node.OnboardNodeOperator(prn, mevSocialize, operatorName, operatorRewardAddress, opts)
@@ -121,6 +123,11 @@ func registerNode(c *cli.Context, operatorName string, operatorRewardAddress com
 	}
 	response.TxHash = tx.Hash()
 
+	response.UnsignedTx, err = eth1.GetUnsignedTx(c, opts, tx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return response
 	return &response, nil
 
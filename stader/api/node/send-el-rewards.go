@@ -5,6 +5,7 @@ import (
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/stader-lib/node"
 	pool_utils "github.com/stader-labs/stader-node/stader-lib/pool-utils"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
 	"github.com/stader-labs/stader-node/stader-lib/tokens"
 	"github.com/urfave/cli"
 	"math/big"
@@ -67,7 +68,7 @@ func CanSendElRewards(c *cli.Context) (*api.CanSendElRewardsResponse, error) {
 	}
 
 	gasInfo, err := node.EstimateWithdrawFromNodeElVault(pnr.Client, operatorElRewardAddress, opts)
-	if err != nil {
+	if err != nil && !stader.IsSimulationError(err) {
 		return nil, err
 	}
 	response.GasInfo = gasInfo
@@ -33,6 +33,7 @@ import (
 	"github.com/stader-labs/stader-node/shared/services"
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/utils/eth1"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
 )
 
 func canNodeSend(c *cli.Context, amountWei *big.Int, token string) (*api.CanNodeSendResponse, error) {
@@ -84,7 +85,7 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string) (*api.CanNode
 		}
 		response.InsufficientBalance = amountWei.Cmp(ethBalanceWei) > 0
 		gasInfo, err := eth.EstimateSendTransactionGas(ec, nodeAccount.Address, opts)
-		if err != nil {
+		if err != nil && !stader.IsSimulationError(err) {
 			return nil, err
 		}
 		response.GasInfo = gasInfo
@@ -98,7 +99,7 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string) (*api.CanNode
 		}
 		response.InsufficientBalance = amountWei.Cmp(sdBalanceWei) > 0
 		gasInfo, err := tokens.EstimateTransferGas(sdt, nodeAccount.Address, amountWei, opts)
-		if err != nil {
+		if err != nil && !stader.IsSimulationError(err) {
 			return nil, err
 		}
 		response.GasInfo = gasInfo
@@ -112,7 +113,7 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string) (*api.CanNode
 		}
 		response.InsufficientBalance = amountWei.Cmp(ethxBalanceWei) > 0
 		gasInfo, err := tokens.EstimateTransferGas(ethxt, nodeAccount.Address, amountWei, opts)
-		if err != nil {
+		if err != nil && !stader.IsSimulationError(err) {
 			return nil, err
 		}
 		response.GasInfo = gasInfo
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
 	"github.com/stader-labs/stader-node/shared/utils/eth1"
 	pool_utils "github.com/stader-labs/stader-node/stader-lib/pool-utils"
@@ -11,15 +12,17 @@ import (
 	"time"
 
 	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/state"
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/utils/stdr"
 	"github.com/stader-labs/stader-node/stader-lib/node"
 	sd_collateral "github.com/stader-labs/stader-node/stader-lib/sd-collateral"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
 	"github.com/stader-labs/stader-node/stader-lib/tokens"
 	"github.com/urfave/cli"
 )
 
-func GetClaimedAndUnclaimedSocializingPoolMerkles(c *cli.Context) ([]stader_backend.CycleMerkleProofs, []stader_backend.CycleMerkleProofs, error) {
+func GetClaimedAndUnclaimedSocializingPoolMerkles(c *cli.Context, opts *bind.CallOpts) ([]stader_backend.CycleMerkleProofs, []stader_backend.CycleMerkleProofs, error) {
 	cfg, err := services.GetConfig(c)
 	if err != nil {
 		return nil, nil, err
@@ -37,13 +40,22 @@ func GetClaimedAndUnclaimedSocializingPoolMerkles(c *cli.Context) ([]stader_back
 		return nil, nil, err
 	}
 
-	rewardDetails, err := socializing_pool.GetRewardDetails(sp, nil)
+	opts, err = stader.PinCallOpts(sp.Client, opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	unclaimedMerkles := []stader_backend.CycleMerkleProofs{}
-	claimedMerkles := []stader_backend.CycleMerkleProofs{}
+	rewardDetails, err := socializing_pool.GetRewardDetails(sp, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mc, err := stader.NewMultiCaller(sp.Client)
+	if err != nil {
+		return nil, nil, err
+	}
+	cachedCycles := []int64{}
+	cachedMerkles := []stader_backend.CycleMerkleProofs{}
 	for i := int64(1); i < rewardDetails.CurrentIndex.Int64(); i++ {
 		cycleMerkleProof, exists, err := cfg.StaderNode.ReadCycleCache(i)
 		if err != nil {
@@ -52,12 +64,24 @@ func GetClaimedAndUnclaimedSocializingPoolMerkles(c *cli.Context) ([]stader_back
 		if !exists {
 			continue
 		}
-		claimed, err := socializing_pool.HasClaimedRewards(sp, nodeAccount.Address, big.NewInt(i), nil)
+		cachedCycles = append(cachedCycles, i)
+		cachedMerkles = append(cachedMerkles, cycleMerkleProof)
+	}
+	claimed := make([]bool, len(cachedMerkles))
+	for i, cycle := range cachedCycles {
+		err := socializing_pool.AddHasClaimedRewardsCall(mc, sp, nodeAccount.Address, big.NewInt(cycle), &claimed[i])
 		if err != nil {
 			return nil, nil, err
 		}
+	}
+	if err := mc.Execute(opts); err != nil {
+		return nil, nil, err
+	}
 
-		if claimed {
+	unclaimedMerkles := []stader_backend.CycleMerkleProofs{}
+	claimedMerkles := []stader_backend.CycleMerkleProofs{}
+	for i, cycleMerkleProof := range cachedMerkles {
+		if claimed[i] {
 			claimedMerkles = append(claimedMerkles, cycleMerkleProof)
 		} else {
 			unclaimedMerkles = append(unclaimedMerkles, cycleMerkleProof)
@@ -67,7 +91,7 @@ func GetClaimedAndUnclaimedSocializingPoolMerkles(c *cli.Context) ([]stader_back
 	return claimedMerkles, unclaimedMerkles, nil
 }
 
-func getStatus(c *cli.Context) (*api.NodeStatusResponse, error) {
+func getStatus(c *cli.Context, blockNumber uint64, slotNumber uint64) (*api.NodeStatusResponse, error) {
 
 	// Get services
 	if err := services.RequireNodeWallet(c); err != nil {
@@ -121,13 +145,40 @@ func getStatus(c *cli.Context) (*api.NodeStatusResponse, error) {
 
 	response.AccountAddress = nodeAccount.Address
 
+	// Take every contract read and Beacon query from the same snapshot
+	var snapshot state.Snapshot
+	switch {
+	case blockNumber != 0:
+		beaconConfig, err := bc.GetEth2Config()
+		if err != nil {
+			return nil, err
+		}
+		snapshot, err = state.GetSnapshotAtBlock(pnr.Client, bc, beaconConfig, blockNumber)
+		if err != nil {
+			return nil, err
+		}
+	case slotNumber != 0:
+		snapshot, err = state.GetSnapshotAtSlot(bc, slotNumber)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		snapshot, err = state.GetHeadSnapshot(bc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	response.SnapshotElBlockNumber = snapshot.ElBlockNumber
+	response.SnapshotBeaconSlot = snapshot.BeaconSlot
+	opts := snapshot.CallOpts()
+
 	//fmt.Printf("Getting node account balances...\n")
-	accountEthBalance, err := tokens.GetEthBalance(pnr.Client, nodeAccount.Address, nil)
+	accountEthBalance, err := tokens.GetEthBalance(pnr.Client, nodeAccount.Address, opts)
 	if err != nil {
 		return nil, err
 	}
 	//fmt.Printf("Getting node account SD balance...\n")
-	accountSdBalance, err := tokens.BalanceOf(sdt, nodeAccount.Address, nil)
+	accountSdBalance, err := tokens.BalanceOf(sdt, nodeAccount.Address, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -136,19 +187,19 @@ func getStatus(c *cli.Context) (*api.NodeStatusResponse, error) {
 	response.AccountBalances.Sd = accountSdBalance
 
 	//fmt.Printf("Getting socializing pool address...\n")
-	socializingPoolAddress, err := stader_config.GetSocializingPoolContractAddress(sdcfg, nil)
+	socializingPoolAddress, err := stader_config.GetSocializingPoolContractAddress(sdcfg, opts)
 	if err != nil {
 		return nil, err
 	}
 	response.SocializingPoolAddress = socializingPoolAddress
 
 	//fmt.Printf("Getting operator id...\n")
-	operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, nil)
+	operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, opts)
 	if err != nil {
 		return nil, err
 	}
 	//fmt.Printf("Getting operator info...\n")
-	operatorRegistry, err := node.GetOperatorInfo(pnr, operatorId, nil)
+	operatorRegistry, err := node.GetOperatorInfo(pnr, operatorId, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -164,31 +215,31 @@ func getStatus(c *cli.Context) (*api.NodeStatusResponse, error) {
 
 		//fmt.Printf("Getting operator node el reward balance\n")
 		// non socializing pool fee recepient
-		operatorElRewardAddress, err := node.GetNodeElRewardAddress(pnr, 1, operatorId, nil)
+		operatorElRewardAddress, err := node.GetNodeElRewardAddress(pnr, 1, operatorId, opts)
 		if err != nil {
 			return nil, err
 		}
 		//fmt.Printf("Getting operator node el reward balance\n")
-		elRewardAddressBalance, err := tokens.GetEthBalance(pnr.Client, operatorElRewardAddress, nil)
+		elRewardAddressBalance, err := tokens.GetEthBalance(pnr.Client, operatorElRewardAddress, opts)
 		if err != nil {
 			return nil, err
 		}
 		//fmt.Printf("Getting operator node el reward share\n")
-		operatorElRewards, err := pool_utils.CalculateRewardShare(putils, 1, elRewardAddressBalance, nil)
+		operatorElRewards, err := pool_utils.CalculateRewardShare(putils, 1, elRewardAddressBalance, opts)
 		if err != nil {
 			return nil, err
 		}
 		response.OperatorELRewardsAddress = operatorElRewardAddress
 		response.OperatorELRewardsAddressBalance = operatorElRewards.OperatorShare
 
-		operatorRewardCollectorBalance, err := node.GetOperatorRewardsCollectorBalance(orc, nodeAccount.Address, nil)
+		operatorRewardCollectorBalance, err := node.GetOperatorRewardsCollectorBalance(orc, nodeAccount.Address, opts)
 		if err != nil {
 			return nil, err
 		}
 		response.OperatorRewardCollectorBalance = operatorRewardCollectorBalance
 
 		//fmt.Printf("Getting operator reward address balance\n")
-		operatorReward, err := tokens.GetEthBalance(pnr.Client, operatorRegistry.OperatorRewardAddress, nil)
+		operatorReward, err := tokens.GetEthBalance(pnr.Client, operatorRegistry.OperatorRewardAddress, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -196,7 +247,7 @@ func getStatus(c *cli.Context) (*api.NodeStatusResponse, error) {
 
 		//fmt.Printf("getting operator sd collateral balance\n")
 		// get operator deposited sd collateral
-		operatorSdCollateral, err := sd_collateral.GetOperatorSdBalance(sdc, nodeAccount.Address, nil)
+		operatorSdCollateral, err := sd_collateral.GetOperatorSdBalance(sdc, nodeAccount.Address, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -204,14 +255,14 @@ func getStatus(c *cli.Context) (*api.NodeStatusResponse, error) {
 
 		//fmt.Printf("getting operator sd collateral worth validators\n")
 		// total registerable validators
-		totalSdWorthValidators, err := sd_collateral.GetMaxValidatorSpawnable(sdc, operatorSdCollateral, 1, nil)
+		totalSdWorthValidators, err := sd_collateral.GetMaxValidatorSpawnable(sdc, operatorSdCollateral, 1, opts)
 		if err != nil {
 			return nil, err
 		}
 		response.SdCollateralWorthValidators = totalSdWorthValidators
 
 		//fmt.Printf("Getting reward details\n")
-		rewardCycleDetails, err := socializing_pool.GetRewardDetails(sp, nil)
+		rewardCycleDetails, err := socializing_pool.GetRewardDetails(sp, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -220,13 +271,13 @@ func getStatus(c *cli.Context) (*api.NodeStatusResponse, error) {
 		response.SocializingPoolStartTime = socializingPoolStartTimestamp
 
 		//fmt.Printf("Get total validator keys\n")
-		totalValidatorKeys, err := node.GetTotalValidatorKeys(pnr, operatorId, nil)
+		totalValidatorKeys, err := node.GetTotalValidatorKeys(pnr, operatorId, opts)
 		if err != nil {
 			return nil, err
 		}
 
 		//fmt.Printf("Get total non terminal validator keys\n")
-		totalNonTerminalValidatorKeys, err := node.GetTotalNonTerminalValidatorKeys(pnr, nodeAccount.Address, totalValidatorKeys, nil)
+		totalNonTerminalValidatorKeys, err := node.GetTotalNonTerminalValidatorKeys(pnr, nodeAccount.Address, totalValidatorKeys, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -235,27 +286,50 @@ func getStatus(c *cli.Context) (*api.NodeStatusResponse, error) {
 		response.TotalNonTerminalValidators = big.NewInt(int64(totalNonTerminalValidatorKeys))
 
 		totalValidatorClRewards := big.NewInt(0)
-		validatorInfoArray := make([]stdr.ValidatorInfo, totalValidatorKeys.Int64())
+		validatorInfoArray := make([]stdr.ValidatorInfo, 0, totalValidatorKeys.Int64())
 
-		validatorInfoMap, _, err := stdr.GetAllValidatorsRegisteredWithOperator(pnr, operatorId, nodeAccount.Address, nil)
+		validatorInfoMap, validatorPubKeys, err := stdr.GetAllValidatorsRegisteredWithOperator(pnr, operatorId, nodeAccount.Address, opts)
+		if err != nil {
+			return nil, err
+		}
+		rewardsThreshold, err := stader_config.GetRewardsThreshold(sdcfg, opts)
 		if err != nil {
 			return nil, err
 		}
 
-		i := 0
-		for _, validatorContractInfo := range validatorInfoMap {
-			withdrawVaultBalance, err := tokens.GetEthBalance(pnr.Client, validatorContractInfo.WithdrawVaultAddress, nil)
-			if err != nil {
+		// Batch the withdraw vault reads of every validator
+		mc, err := stader.NewMultiCaller(pnr.Client)
+		if err != nil {
+			return nil, err
+		}
+		withdrawVaultBalances := make([]*big.Int, len(validatorPubKeys))
+		withdrawVaultWithdrawShares := make([]types.RewardShare, len(validatorPubKeys))
+		for i, pubKey := range validatorPubKeys {
+			withdrawVaultAddress := validatorInfoMap[pubKey].WithdrawVaultAddress
+			if err := tokens.AddGetEthBalanceCall(mc, withdrawVaultAddress, &withdrawVaultBalances[i]); err != nil {
 				return nil, err
 			}
-			withdrawVaultRewardShares, err := pool_utils.CalculateRewardShare(putils, 1, withdrawVaultBalance, nil)
-			if err != nil {
+			if err := node.AddCalculateValidatorWithdrawVaultWithdrawShareCall(mc, pnr.Client, withdrawVaultAddress, &withdrawVaultWithdrawShares[i]); err != nil {
 				return nil, err
 			}
-			rewardsThreshold, err := stader_config.GetRewardsThreshold(sdcfg, nil)
-			if err != nil {
+		}
+		if err := mc.Execute(opts); err != nil {
+			return nil, err
+		}
+		rewardShares := make([]types.RewardShare, len(validatorPubKeys))
+		for i := range validatorPubKeys {
+			if err := pool_utils.AddCalculateRewardShareCall(mc, putils, 1, withdrawVaultBalances[i], &rewardShares[i]); err != nil {
 				return nil, err
 			}
+		}
+		if err := mc.Execute(opts); err != nil {
+			return nil, err
+		}
+
+		for i, pubKey := range validatorPubKeys {
+			validatorContractInfo := validatorInfoMap[pubKey]
+			withdrawVaultBalance := withdrawVaultBalances[i]
+			withdrawVaultRewardShares := rewardShares[i]
 			crossedRewardThreshold := false
 			if withdrawVaultBalance.Cmp(rewardsThreshold) > 0 {
 				crossedRewardThreshold = true
@@ -263,13 +337,9 @@ func getStatus(c *cli.Context) (*api.NodeStatusResponse, error) {
 				totalValidatorClRewards.Add(totalValidatorClRewards, withdrawVaultRewardShares.OperatorShare)
 			}
 
-			withdrawVaultWithdrawShares, err := node.CalculateValidatorWithdrawVaultWithdrawShare(pnr.Client, validatorContractInfo.WithdrawVaultAddress, nil)
-			if err != nil {
-				return nil, err
-			}
-			validatorWithdrawVaultWithdrawShares := withdrawVaultWithdrawShares.OperatorShare
+			validatorWithdrawVaultWithdrawShares := withdrawVaultWithdrawShares[i].OperatorShare
 
-			validatorBeaconStatus, err := bc.GetValidatorStatus(types.BytesToValidatorPubkey(validatorContractInfo.Pubkey), nil)
+			validatorBeaconStatus, err := bc.GetValidatorStatus(pubKey, snapshot.ValidatorStatusOptions())
 			if err != nil {
 				return nil, err
 			}
@@ -305,16 +375,14 @@ func getStatus(c *cli.Context) (*api.NodeStatusResponse, error) {
 				WithdrawnTime:                    withdrawTime,
 			}
 
-			validatorInfoArray[i] = validatorInfo
-
-			i += 1
+			validatorInfoArray = append(validatorInfoArray, validatorInfo)
 		}
 
 		response.ValidatorInfos = validatorInfoArray
 		response.TotalValidatorClRewards = totalValidatorClRewards
 
 		//fmt.Printf("Getting operator claimed and unclaimed socializing pool merkles\n")
-		claimedMerkles, unclaimedMerkles, err := GetClaimedAndUnclaimedSocializingPoolMerkles(c)
+		claimedMerkles, unclaimedMerkles, err := GetClaimedAndUnclaimedSocializingPoolMerkles(c, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,178 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/txs"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+func getPendingTransactions(c *cli.Context) (*api.PendingTransactionsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	store, err := services.GetTransactionStore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PendingTransactionsResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Settle the transactions that are no longer pending
+	if err := txs.UpdatePendingTransactions(store, ec, nodeAccount.Address); err != nil {
+		return nil, err
+	}
+
+	// Get the pending transactions
+	response.Transactions, err = store.GetPendingTransactions(nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func canReplaceTransaction(c *cli.Context, hash common.Hash, cancel bool) (*api.CanReplaceTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	store, err := services.GetTransactionStore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanReplaceTransactionResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the transaction
+	tx, found, err := getLatestTransaction(store, ec, nodeAccount.Address, hash)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		response.NotFound = true
+		return &response, nil
+	}
+	response.Transaction = tx
+	response.NotPending = (tx.Status != txs.TxStatus_Pending)
+
+	// Get the replacement fees & gas
+	response.MinMaxFee, response.MinMaxPriorityFee = txs.GetMinReplacementFees(tx)
+	gasLimit := tx.GasLimit
+	if cancel {
+		gasLimit = txs.CancelGasLimit
+	}
+	response.GasInfo = stader.GasInfo{
+		EstGasLimit:  gasLimit,
+		SafeGasLimit: gasLimit,
+	}
+
+	// Update & return response
+	response.CanReplace = !response.NotPending
+	return &response, nil
+
+}
+
+func replaceTransaction(c *cli.Context, hash common.Hash, cancel bool) (*api.ReplaceTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	store, err := services.GetTransactionStore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ReplaceTransactionResponse{}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the transaction
+	tx, found, err := getLatestTransaction(store, ec, opts.From, hash)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("Transaction %s was not sent by the node wallet", hash.Hex())
+	}
+
+	// Replace it
+	if cancel {
+		response.TxHash, err = txs.Cancel(ec, w.GetChainID(), opts, tx)
+	} else {
+		response.TxHash, err = txs.SpeedUp(ec, w.GetChainID(), opts, tx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get a transaction sent by the node account, or the latest transaction replacing it, after settling the ones that are no longer pending
+func getLatestTransaction(store *txs.Store, ec stader.ExecutionClient, from common.Address, hash common.Hash) (txs.Transaction, bool, error) {
+	if err := txs.UpdatePendingTransactions(store, ec, from); err != nil {
+		return txs.Transaction{}, false, err
+	}
+	hash, _, _, err := store.GetReplacement(hash)
+	if err != nil {
+		return txs.Transaction{}, false, err
+	}
+	return store.GetTransaction(hash)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/utils/eth1"
 	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
 	stader_config "github.com/stader-labs/stader-node/stader-lib/stader-config"
 	"github.com/urfave/cli"
 )
@@ -80,7 +81,7 @@ func CanUpdateOperatorName(c *cli.Context, operatorName string) (*api.CanUpdateO
 
 	// estimate gas
 	gasInfo, err := node.EstimateUpdateOperatorDetails(pnr, operatorName, operatorInfo.OperatorRewardAddress, opts)
-	if err != nil {
+	if err != nil && !stader.IsSimulationError(err) {
 		return nil, err
 	}
 
@@ -106,6 +107,7 @@ func UpdateOperatorName(c *cli.Context, operatorName string) (*api.UpdateOperato
 	if err != nil {
 		return nil, fmt.Errorf("error checking for nonce override: %w", err)
 	}
+	eth1.CheckForUnsignedTx(c, opts)
 
 	nodeAccount, err := w.GetNodeAccount()
 	if err != nil {
@@ -131,5 +133,10 @@ func UpdateOperatorName(c *cli.Context, operatorName string) (*api.UpdateOperato
 
 	response.TxHash = tx.Hash()
 
+	response.UnsignedTx, err = eth1.GetUnsignedTx(c, opts, tx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &response, nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/utils/eth1"
 	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
 	"github.com/urfave/cli"
 )
 
@@ -73,7 +74,7 @@ func CanUpdateOperatorRewardAddress(c *cli.Context, operatorRewardAddress common
 
 	// estimate gas
 	gasInfo, err := node.EstimateUpdateOperatorDetails(pnr, operatorInfo.OperatorName, operatorRewardAddress, opts)
-	if err != nil {
+	if err != nil && !stader.IsSimulationError(err) {
 		return nil, err
 	}
 
@@ -99,6 +100,7 @@ func UpdateOperatorRewardAddress(c *cli.Context, operatorRewardAddress common.Ad
 	if err != nil {
 		return nil, fmt.Errorf("error checking for nonce override: %w", err)
 	}
+	eth1.CheckForUnsignedTx(c, opts)
 
 	nodeAccount, err := w.GetNodeAccount()
 	if err != nil {
@@ -124,5 +126,10 @@ func UpdateOperatorRewardAddress(c *cli.Context, operatorRewardAddress common.Ad
 
 	response.TxHash = tx.Hash()
 
+	response.UnsignedTx, err = eth1.GetUnsignedTx(c, opts, tx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &response, nil
 }
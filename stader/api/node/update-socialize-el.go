@@ -6,6 +6,7 @@ import (
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/utils/eth1"
 	node "github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
 	stader_config "github.com/stader-labs/stader-node/stader-lib/stader-config"
 	"github.com/urfave/cli"
 )
@@ -90,7 +91,7 @@ func canUpdateSocializeEl(c *cli.Context, socializeEl bool) (*api.CanUpdateSocia
 		return nil, err
 	}
 	gasInfo, err := node.EstimateChangeSocializingPoolState(pnr, socializeEl, opts)
-	if err != nil {
+	if err != nil && !stader.IsSimulationError(err) {
 		return nil, err
 	}
 	response.GasInfo = gasInfo
@@ -112,6 +113,7 @@ func updateSocializeEl(c *cli.Context, socializeEl bool) (*api.UpdateSocializeEl
 	if err != nil {
 		return nil, fmt.Errorf("error checking for nonce override: %w", err)
 	}
+	eth1.CheckForUnsignedTx(c, opts)
 	pnr, err := services.GetPermissionlessNodeRegistry(c)
 	if err != nil {
 		return nil, err
@@ -127,6 +129,11 @@ func updateSocializeEl(c *cli.Context, socializeEl bool) (*api.UpdateSocializeEl
 
 	response.TxHash = tx.Hash()
 
+	response.UnsignedTx, err = eth1.GetUnsignedTx(c, opts, tx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return response
 	return &response, nil
 
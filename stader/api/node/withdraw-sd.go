@@ -7,6 +7,7 @@ import (
 	"github.com/stader-labs/stader-node/shared/utils/eth1"
 	"github.com/stader-labs/stader-node/stader-lib/node"
 	sd_collateral "github.com/stader-labs/stader-node/stader-lib/sd-collateral"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
 	"github.com/urfave/cli"
 	"math/big"
 )
@@ -87,7 +88,7 @@ func canWithdrawSd(c *cli.Context, amountWei *big.Int) (*api.CanWithdrawSdRespon
 	}
 
 	gasInfo, err := sd_collateral.EstimateWithdrawSd(sdc, amountWei, opts)
-	if err != nil {
+	if err != nil && !stader.IsSimulationError(err) {
 		return nil, err
 	}
 	response.GasInfo = gasInfo
@@ -117,6 +118,7 @@ func withdrawSd(c *cli.Context, amountWei *big.Int) (*api.WithdrawSdResponse, er
 	if err != nil {
 		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
 	}
+	eth1.CheckForUnsignedTx(c, opts)
 	tx, err := sd_collateral.WithdrawSd(sdc, amountWei, opts)
 	if err != nil {
 		return nil, err
@@ -124,6 +126,11 @@ func withdrawSd(c *cli.Context, amountWei *big.Int) (*api.WithdrawSdResponse, er
 
 	response.TxHash = tx.Hash()
 
+	response.UnsignedTx, err = eth1.GetUnsignedTx(c, opts, tx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return response
 	return &response, nil
 }
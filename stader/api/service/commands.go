@@ -70,6 +70,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "get-gas-prices",
+				Usage:     "Get max fee and priority fee suggestions from the fee history of recent blocks",
+				UsageText: "stader-cli api service get-gas-prices",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getGasPrices(c))
+					return nil
+
+				},
+			},
 		},
 	})
 }
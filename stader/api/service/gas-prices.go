@@ -0,0 +1,34 @@
+package service
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/gas/feehistory"
+	"github.com/stader-labs/stader-node/shared/types/api"
+)
+
+func getGasPrices(c *cli.Context) (*api.GasPricesResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GasPricesResponse{}
+
+	// Get the gas prices from the fee history of recent blocks
+	response.GasPrices, err = feehistory.GetGasPrices(ec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
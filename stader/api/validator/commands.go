@@ -158,6 +158,22 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "can-send-all-cl-rewards",
+				Usage:     "Get the cl rewards of the withdraw vaults of all validators of the node",
+				UsageText: "stader-cli api validator can-send-all-cl-rewards minimum-wei",
+				Action: func(c *cli.Context) error {
+
+					minimum, err := cliutils.ValidateWeiAmount("minimum", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					api.PrintResponse(CanSendAllClRewards(c, minimum))
+					return nil
+
+				},
+			},
 			{
 				Name:      "send-cl-rewards",
 				Usage:     "Send cl rewards of a validator to the operator claim vault",
@@ -174,6 +190,64 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "presign-status",
+				Usage:     "Get the presigned exit message status of all validators registered with the operator",
+				UsageText: "stader-cli api validator presign-status",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					api.PrintResponse(getPresignStatus(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "verify-exits",
+				Usage:     "Verify the locally stored presigned exit messages against the validator keys and the network's fork schedule",
+				UsageText: "stader-cli api validator verify-exits",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					api.PrintResponse(verifyExits(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "performance",
+				Usage:     "Get the consensus duties and rewards of all validators registered with the operator over an epoch range",
+				UsageText: "stader-cli api validator performance [--start-epoch epoch] [--end-epoch epoch]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "start-epoch",
+						Usage: "First epoch of the range",
+					},
+					cli.Uint64Flag{
+						Name:  "end-epoch",
+						Usage: "Last epoch of the range",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					api.PrintResponse(getValidatorPerformance(c, c.Uint64("start-epoch"), c.Uint64("end-epoch")))
+					return nil
+
+				},
+			},
 		},
 	})
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/utils/eth1"
 	"github.com/stader-labs/stader-node/shared/utils/validator"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
 )
 
 func canNodeDeposit(c *cli.Context, amountWei *big.Int, numValidators *big.Int, reloadKeys bool) (*api.CanNodeDepositResponse, error) {
@@ -194,11 +195,11 @@ func canNodeDeposit(c *cli.Context, amountWei *big.Int, numValidators *big.Int,
 	}
 
 	gasInfo, err := node.EstimateAddValidatorKeys(prn, pubKeys, preDepositSignatures, depositSignatures, opts)
-	if err != nil {
+	if err != nil && !stader.IsSimulationError(err) {
 		return nil, err
 	}
 
-	canNodeDepositResponse.CanDeposit = true
+	canNodeDepositResponse.CanDeposit = !gasInfo.Simulation.Failed()
 	canNodeDepositResponse.GasInfo = gasInfo
 
 	return &canNodeDepositResponse, nil
@@ -344,6 +345,7 @@ func nodeDeposit(c *cli.Context, amountWei *big.Int, numValidators *big.Int, rel
 	if err != nil {
 		return nil, fmt.Errorf("error checking for nonce override: %w", err)
 	}
+	eth1.CheckForUnsignedTx(c, opts)
 
 	tx, err := node.AddValidatorKeys(prn, pubKeys, preDepositSignatures, depositSignatures, opts)
 	if err != nil {
@@ -357,6 +359,11 @@ func nodeDeposit(c *cli.Context, amountWei *big.Int, numValidators *big.Int, rel
 
 	response.TxHash = tx.Hash()
 
+	response.UnsignedTx, err = eth1.GetUnsignedTx(c, opts, tx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return response
 	return &response, nil
 
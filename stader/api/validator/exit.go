@@ -3,11 +3,10 @@ package validator
 import (
 	"github.com/stader-labs/stader-node/shared/services"
 	"github.com/stader-labs/stader-node/shared/types/api"
+	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
 	"github.com/stader-labs/stader-node/shared/utils/eth2"
-	"github.com/stader-labs/stader-node/shared/utils/validator"
 	"github.com/stader-labs/stader-node/stader-lib/types"
 	"github.com/urfave/cli"
-	eth2types "github.com/wealdtech/go-eth2-types/v2"
 )
 
 func canExitValidator(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*api.CanExitValidatorResponse, error) {
@@ -23,7 +22,7 @@ func canExitValidator(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*a
 	if err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
+	vs, err := services.GetValidatorSigner(c)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +30,7 @@ func canExitValidator(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*a
 	response := api.CanExitValidatorResponse{}
 
 	// check if the validator is key is available to sign the exit message
-	_, err = w.GetValidatorKeyByPubkey(validatorPubKey)
+	err = vs.CheckValidatorKey(validatorPubKey)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +69,7 @@ func canExitValidator(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*a
 
 func exitValidator(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*api.ExitValidatorResponse, error) {
 
-	w, err := services.GetWallet(c)
+	vs, err := services.GetValidatorSigner(c)
 	if err != nil {
 		return nil, err
 	}
@@ -92,25 +91,24 @@ func exitValidator(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*api.
 		return nil, err
 	}
 
-	// Get voluntary exit signature domain
-	signatureDomain, err := bc.GetDomainData(eth2types.DomainVoluntaryExit[:], head.Epoch, false)
+	// Get the network's fork schedule to sign the voluntary exit for
+	eth2Config, err := bc.GetEth2Config()
 	if err != nil {
 		return nil, err
 	}
-
-	// Get validator index
-	validatorIndex, err := bc.GetValidatorIndex(validatorPubKey)
+	schedule, err := eth2.GetCheckedForkSchedule(cfg.StaderNode.Network.Value.(cfgtypes.Network), eth2Config)
 	if err != nil {
 		return nil, err
 	}
 
-	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubKey)
+	// Get validator index
+	validatorIndex, err := bc.GetValidatorIndex(validatorPubKey)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get signed voluntary exit message
-	signature, _, err := validator.GetSignedExitMessage(validatorKey, validatorIndex, head.Epoch, signatureDomain)
+	signature, err := vs.SignVoluntaryExit(validatorPubKey, validatorIndex, head.Epoch, schedule)
 	if err != nil {
 		return nil, err
 	}
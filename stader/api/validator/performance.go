@@ -0,0 +1,284 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	penalty_tracker "github.com/stader-labs/stader-node/stader-lib/penalty-tracker"
+)
+
+// Epochs reported when no start epoch is given, about a day
+const defaultPerformanceEpochs = 225
+
+// Largest epoch range a single report can cover, about a week
+const maxPerformanceEpochs = 1575
+
+// Get the consensus performance of the operator's validators. A start or end epoch of 0 uses the default range.
+func getValidatorPerformance(c *cli.Context, startEpoch uint64, endEpoch uint64) (*api.ValidatorPerformanceResponse, error) {
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	pt, err := services.GetPenaltyTrackerContract(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ValidatorPerformanceResponse{}
+
+	// Attestation rewards of an epoch are only settled once the following epoch is over
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+	if head.Epoch < 2 {
+		return nil, fmt.Errorf("the Beacon chain has no completed epochs yet")
+	}
+	lastSettledEpoch := head.Epoch - 2
+	if endEpoch == 0 {
+		endEpoch = lastSettledEpoch
+	}
+	if endEpoch > lastSettledEpoch {
+		return nil, fmt.Errorf("end epoch %d is not settled yet, the latest settled epoch is %d", endEpoch, lastSettledEpoch)
+	}
+	if startEpoch == 0 && endEpoch >= defaultPerformanceEpochs {
+		startEpoch = endEpoch - defaultPerformanceEpochs + 1
+	}
+	if startEpoch > endEpoch {
+		return nil, fmt.Errorf("start epoch %d is after end epoch %d", startEpoch, endEpoch)
+	}
+	if endEpoch-startEpoch+1 > maxPerformanceEpochs {
+		return nil, fmt.Errorf("the epoch range cannot cover more than %d epochs", maxPerformanceEpochs)
+	}
+	response.StartEpoch = startEpoch
+	response.EndEpoch = endEpoch
+
+	response.MissedAttestationPenaltyPerStrike, err = penalty_tracker.GetMissedAttestationPenaltyPerStrike(pt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	_, validatorPubKeys, err := stdr.GetAllValidatorsRegisteredWithOperator(pnr, operatorId, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(validatorPubKeys) == 0 {
+		return &response, nil
+	}
+
+	beaconStatuses, err := bc.GetValidatorStatuses(validatorPubKeys, nil)
+	if err != nil {
+		return nil, err
+	}
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+
+	// Only validators known to the Beacon chain have duties
+	performances := map[uint64]*api.ValidatorPerformance{}
+	statuses := map[uint64]beacon.ValidatorStatus{}
+	for _, validatorPubKey := range validatorPubKeys {
+		status := beaconStatuses[validatorPubKey]
+		if !status.Exists {
+			continue
+		}
+		statuses[status.Index] = status
+		performances[status.Index] = &api.ValidatorPerformance{
+			Pubkey: validatorPubKey,
+			Index:  status.Index,
+		}
+	}
+
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		activeIndices := []uint64{}
+		for index, status := range statuses {
+			if status.ActivationEpoch <= epoch && epoch < status.ExitEpoch {
+				activeIndices = append(activeIndices, index)
+			}
+		}
+		if len(activeIndices) == 0 {
+			continue
+		}
+
+		if err := addAttestationPerformance(bc, epoch, activeIndices, statuses, performances); err != nil {
+			return nil, err
+		}
+		if err := addProposalPerformance(bc, eth2Config, epoch, activeIndices, performances); err != nil {
+			return nil, err
+		}
+		if err := addSyncCommitteePerformance(bc, eth2Config, epoch, activeIndices, performances); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, validatorPubKey := range validatorPubKeys {
+		status := beaconStatuses[validatorPubKey]
+		if !status.Exists {
+			continue
+		}
+		performance := performances[status.Index]
+		if performance.IdealAttestationRewards > 0 {
+			performance.AttestationEffectiveness = float64(performance.AttestationRewards) / float64(performance.IdealAttestationRewards) * 100
+		}
+		response.Validators = append(response.Validators, *performance)
+	}
+
+	return &response, nil
+}
+
+// Add the attestation rewards and misses of an epoch
+func addAttestationPerformance(bc beacon.Client, epoch uint64, indices []uint64, statuses map[uint64]beacon.ValidatorStatus, performances map[uint64]*api.ValidatorPerformance) error {
+	rewards, err := bc.GetAttestationRewards(epoch, indices)
+	if err != nil {
+		return err
+	}
+	if len(rewards.IdealRewards) == 0 {
+		return nil
+	}
+
+	// Ideal rewards are given per effective balance. Only the current effective balance is known, so the
+	// highest one is used if the validator's balance was different at the time.
+	idealRewards := map[uint64]beacon.IdealAttestationReward{}
+	highestIdeal := rewards.IdealRewards[0]
+	for _, ideal := range rewards.IdealRewards {
+		idealRewards[ideal.EffectiveBalance] = ideal
+		if ideal.EffectiveBalance > highestIdeal.EffectiveBalance {
+			highestIdeal = ideal
+		}
+	}
+
+	for _, reward := range rewards.TotalRewards {
+		performance, exists := performances[reward.ValidatorIndex]
+		if !exists {
+			continue
+		}
+		ideal, exists := idealRewards[statuses[reward.ValidatorIndex].EffectiveBalance]
+		if !exists {
+			ideal = highestIdeal
+		}
+
+		performance.AttestationDuties++
+		if ideal.Source > 0 && reward.Source <= 0 {
+			performance.MissedSources++
+		}
+		if ideal.Target > 0 && reward.Target <= 0 {
+			performance.MissedTargets++
+		}
+		if ideal.Head > 0 && reward.Head <= 0 {
+			performance.MissedHeads++
+		}
+		performance.AttestationRewards += reward.Head + reward.Target + reward.Source + reward.Inactivity
+		performance.IdealAttestationRewards += ideal.Head + ideal.Target + ideal.Source
+	}
+
+	return nil
+}
+
+// Add the proposals scheduled in an epoch and their rewards
+func addProposalPerformance(bc beacon.Client, eth2Config beacon.Eth2Config, epoch uint64, indices []uint64, performances map[uint64]*api.ValidatorPerformance) error {
+	duties, err := bc.GetValidatorProposerDuties(indices, epoch)
+	if err != nil {
+		return err
+	}
+	scheduled := map[uint64]bool{}
+	for index, count := range duties {
+		if count > 0 {
+			scheduled[index] = true
+		}
+	}
+	if len(scheduled) == 0 {
+		return nil
+	}
+
+	// A scheduled proposal is missed unless one of the epoch's blocks was proposed by the validator
+	proposed := map[uint64]bool{}
+	firstSlot := epoch * eth2Config.SlotsPerEpoch
+	for slot := firstSlot; slot < firstSlot+eth2Config.SlotsPerEpoch; slot++ {
+		blockRewards, exists, err := bc.GetBlockRewards(fmt.Sprintf("%d", slot))
+		if err != nil {
+			return err
+		}
+		if !exists || !scheduled[blockRewards.ProposerIndex] {
+			continue
+		}
+		proposed[blockRewards.ProposerIndex] = true
+		performances[blockRewards.ProposerIndex].ProposalRewards += blockRewards.Total
+	}
+
+	for index := range scheduled {
+		performances[index].ProposalsScheduled++
+		if !proposed[index] {
+			performances[index].ProposalsMissed++
+		}
+	}
+
+	return nil
+}
+
+// Add the sync committee rewards and misses of an epoch
+func addSyncCommitteePerformance(bc beacon.Client, eth2Config beacon.Eth2Config, epoch uint64, indices []uint64, performances map[uint64]*api.ValidatorPerformance) error {
+	// Only sync committee members are returned, so the first block of the epoch tells which validators to follow
+	members := indices
+	firstSlot := epoch * eth2Config.SlotsPerEpoch
+	for slot := firstSlot; slot < firstSlot+eth2Config.SlotsPerEpoch; slot++ {
+		rewards, exists, err := bc.GetSyncCommitteeRewards(fmt.Sprintf("%d", slot), members)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		if len(rewards) == 0 {
+			return nil
+		}
+
+		members = make([]uint64, 0, len(rewards))
+		for _, reward := range rewards {
+			performance, exists := performances[reward.ValidatorIndex]
+			if !exists {
+				continue
+			}
+			members = append(members, reward.ValidatorIndex)
+			performance.SyncCommitteeDuties++
+			if reward.Reward < 0 {
+				performance.SyncCommitteeMissed++
+			}
+			performance.SyncCommitteeRewards += reward.Reward
+		}
+		if len(members) == 0 {
+			return nil
+		}
+	}
+
+	return nil
+}
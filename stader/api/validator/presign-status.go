@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/eth2"
+	"github.com/stader-labs/stader-node/shared/utils/stader"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+)
+
+func getPresignStatus(c *cli.Context) (*api.PresignStatusResponse, error) {
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PresignStatusResponse{}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	registeredValidators, validatorPubKeys, err := stdr.GetAllValidatorsRegisteredWithOperator(pnr, operatorId, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(validatorPubKeys) == 0 {
+		return &response, nil
+	}
+
+	preSignRegisteredMap, err := stader.BulkIsPresignedKeyRegistered(c, validatorPubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	beaconStatuses, err := bc.GetValidatorStatuses(validatorPubKeys, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, validatorPubKey := range validatorPubKeys {
+		validatorContractInfo := registeredValidators[validatorPubKey]
+		beaconStatus := beaconStatuses[validatorPubKey]
+
+		validatorPresignStatus := api.ValidatorPresignStatus{
+			Pubkey:                  validatorPubKey,
+			ContractStatus:          validatorContractInfo.Status,
+			ContractStatusToDisplay: stdr.ValidatorState[validatorContractInfo.Status],
+			ExistsOnBeaconChain:     beaconStatus.Exists,
+			BeaconStatus:            beaconStatus.Status,
+			PresignRegistered:       preSignRegisteredMap[validatorPubKey.String()],
+		}
+
+		// an active validator without a presigned exit on file can be force exited or penalised by stader
+		if beaconStatus.Exists && eth2.IsValidatorActive(beaconStatus) && !validatorPresignStatus.PresignRegistered {
+			validatorPresignStatus.PresignMissing = true
+			response.MissingPresignCount++
+		}
+
+		response.Validators = append(response.Validators, validatorPresignStatus)
+	}
+
+	return &response, nil
+}
@@ -3,12 +3,15 @@ package validator
 import (
 	"github.com/stader-labs/stader-node/shared/services"
 	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
 	"github.com/stader-labs/stader-node/stader-lib/node"
 	pool_utils "github.com/stader-labs/stader-node/stader-lib/pool-utils"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
 	stader_config "github.com/stader-labs/stader-node/stader-lib/stader-config"
 	"github.com/stader-labs/stader-node/stader-lib/tokens"
 	"github.com/stader-labs/stader-node/stader-lib/types"
 	"github.com/urfave/cli"
+	"math/big"
 )
 
 func CanSendClRewards(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*api.CanSendClRewardsResponse, error) {
@@ -89,7 +92,7 @@ func CanSendClRewards(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*a
 	}
 
 	gasInfo, err := node.EstimateDistributeRewards(pnr.Client, validatorContractInfo.WithdrawVaultAddress, opts)
-	if err != nil {
+	if err != nil && !stader.IsSimulationError(err) {
 		return nil, err
 	}
 	response.GasInfo = gasInfo
@@ -97,6 +100,70 @@ func CanSendClRewards(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*a
 	return &response, nil
 }
 
+func CanSendAllClRewards(c *cli.Context, minimum *big.Int) (*api.CanSendAllClRewardsResponse, error) {
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	// Get services
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	sdcfg, err := services.GetStaderConfigContract(c)
+	if err != nil {
+		return nil, err
+	}
+	putils, err := services.GetPoolUtilsContract(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanSendAllClRewardsResponse{}
+
+	vaults, err := stdr.GetClRewardsVaults(pnr, putils, sdcfg, nodeAccount.Address, minimum, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	response.Vaults = []api.ClRewardsVault{}
+	for _, vault := range vaults {
+		vaultInfo := api.ClRewardsVault{
+			Pubkey:               vault.Pubkey,
+			WithdrawVaultAddress: vault.WithdrawVaultAddress,
+			Balance:              vault.Balance,
+			OperatorShare:        vault.OperatorShare,
+			State:                string(vault.State),
+		}
+		if vault.State == stdr.ClRewardsVaultState_Eligible {
+			gasInfo, err := node.EstimateDistributeRewards(pnr.Client, vault.WithdrawVaultAddress, opts)
+			if err != nil && !stader.IsSimulationError(err) {
+				return nil, err
+			}
+			vaultInfo.GasInfo = gasInfo
+		}
+		response.Vaults = append(response.Vaults, vaultInfo)
+	}
+
+	return &response, nil
+}
+
 func SendClRewards(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*api.SendClRewardsResponse, error) {
 	w, err := services.GetWallet(c)
 	if err != nil {
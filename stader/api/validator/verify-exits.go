@@ -0,0 +1,128 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/services/presign"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
+	"github.com/stader-labs/stader-node/shared/utils/eth2"
+	"github.com/stader-labs/stader-node/shared/utils/validator"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Check the locally stored presigned exit messages against the validator keys and the network's fork schedule
+func verifyExits(c *cli.Context) (*api.VerifyExitsResponse, error) {
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	exitStore, err := services.GetPresignedExitStore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.VerifyExitsResponse{
+		StoreEnabled: cfg.StaderNode.StorePresignedExits.Value == true,
+	}
+
+	exits := exitStore.GetExits()
+	if len(exits) == 0 {
+		return &response, nil
+	}
+
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+	response.CurrentEpoch = head.Epoch
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := eth2.GetCheckedForkSchedule(cfg.StaderNode.Network.Value.(cfgtypes.Network), eth2Config)
+	if err != nil {
+		return nil, err
+	}
+
+	pubkeys := make([]types.ValidatorPubkey, len(exits))
+	for i, exit := range exits {
+		pubkeys[i] = exit.Pubkey
+	}
+	beaconStatuses, err := bc.GetValidatorStatuses(pubkeys, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, exit := range exits {
+		verifiedExit, err := verifyExit(schedule, head.Epoch, exit, beaconStatuses[exit.Pubkey])
+		if err != nil {
+			return nil, err
+		}
+		if !verifiedExit.Usable {
+			response.UnusableCount++
+		}
+		response.Exits = append(response.Exits, verifiedExit)
+	}
+
+	return &response, nil
+}
+
+// Check a stored exit message, listing every problem that would get it rejected by the Beacon chain
+func verifyExit(schedule eth2.ForkSchedule, currentEpoch uint64, exit presign.StoredExit, beaconStatus beacon.ValidatorStatus) (api.VerifiedExit, error) {
+	verifiedExit := api.VerifiedExit{
+		Pubkey:         exit.Pubkey,
+		ValidatorIndex: exit.ValidatorIndex,
+		Epoch:          exit.Epoch,
+		SignedAt:       exit.SignedAt,
+		Problems:       []string{},
+	}
+
+	expectedVersion := schedule.AcceptedVoluntaryExitForkVersion(exit.Epoch, currentEpoch)
+	verifiedExit.ExpectedFork = schedule.ForkName(expectedVersion)
+
+	// Find the fork version the message was signed with
+	versions := [][]byte{expectedVersion, schedule.GenesisForkVersion}
+	for _, fork := range schedule.Forks {
+		versions = append(versions, fork.Version)
+	}
+	for _, version := range versions {
+		valid, err := validator.VerifyExitMessage(exit.Pubkey, exit.ValidatorIndex, exit.Epoch, schedule.VoluntaryExitDomainForVersion(version), exit.Signature)
+		if err != nil {
+			return api.VerifiedExit{}, fmt.Errorf("could not verify the exit message of validator %s: %w", exit.Pubkey.Hex(), err)
+		}
+		if valid {
+			verifiedExit.SignedWithFork = schedule.ForkName(version)
+			break
+		}
+	}
+
+	if verifiedExit.SignedWithFork == "" {
+		verifiedExit.Problems = append(verifiedExit.Problems, "the signature does not match the validator key for any fork of the network")
+	} else if verifiedExit.SignedWithFork != verifiedExit.ExpectedFork {
+		verifiedExit.Problems = append(verifiedExit.Problems, fmt.Sprintf("signed with the %s fork version, but the Beacon chain only accepts the %s fork version for this exit", verifiedExit.SignedWithFork, verifiedExit.ExpectedFork))
+	}
+	if exit.Epoch > currentEpoch {
+		verifiedExit.Problems = append(verifiedExit.Problems, fmt.Sprintf("the exit epoch %d is in the future, it cannot be used before then", exit.Epoch))
+	}
+	if !beaconStatus.Exists {
+		verifiedExit.Problems = append(verifiedExit.Problems, "the validator is not on the Beacon chain")
+	} else if beaconStatus.Index != exit.ValidatorIndex {
+		verifiedExit.Problems = append(verifiedExit.Problems, fmt.Sprintf("signed for validator index %d, but the validator has index %d", exit.ValidatorIndex, beaconStatus.Index))
+	}
+
+	verifiedExit.Usable = len(verifiedExit.Problems) == 0
+	return verifiedExit, nil
+}
@@ -0,0 +1,53 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/validator"
+)
+
+func changePassword(c *cli.Context, currentPassword string, newPassword string) (*api.ChangePasswordResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ChangePasswordResponse{}
+
+	// Re-encrypt the wallet & validator keystores
+	if err := w.ChangePassword(currentPassword, newPassword); err != nil {
+		return nil, err
+	}
+
+	// Restart the validator client so it reads the re-encrypted keystores
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	d, err := services.GetDocker(c)
+	if err != nil {
+		return nil, err
+	}
+	if err := validator.RestartValidator(cfg, bc, nil, d); err != nil {
+		return nil, fmt.Errorf("The wallet password was changed, but the validator client could not be restarted: %w", err)
+	}
+	response.RestartedValidator = true
+
+	// Return response
+	return &response, nil
+
+}
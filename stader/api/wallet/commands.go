@@ -20,6 +20,8 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package wallet
 
 import (
+	"fmt"
+
 	"github.com/urfave/cli"
 
 	"github.com/stader-labs/stader-node/shared/utils/api"
@@ -76,6 +78,29 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "change-password",
+				Usage:     "Change the node wallet password and re-encrypt the wallet and validator keystores",
+				UsageText: "stader-cli api wallet change-password current-password new-password",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					currentPassword := c.Args().Get(0)
+					newPassword, err := cliutils.ValidateNodePassword("new wallet password", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(changePassword(c, currentPassword, newPassword))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "recover",
 				Aliases:   []string{"r"},
@@ -139,6 +164,42 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "import-keystores",
+				Usage:     "Import validator keys from EIP-2335 keystores encrypted with the same password",
+				UsageText: "stader-cli api wallet import-keystores password keystore-json [keystore-json...]",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if len(c.Args()) < 2 {
+						return fmt.Errorf("incorrect argument count; usage: %s", c.Command.UsageText)
+					}
+
+					// Run
+					api.PrintResponse(importKeystores(c, c.Args().First(), c.Args().Tail()))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "release-imported-keys",
+				Usage:     "Load the held imported validator keys whose slashing protection history is in the validator client into it",
+				UsageText: "stader-cli api wallet release-imported-keys",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(releaseImportedKeys(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "export",
 				Aliases:   []string{"e"},
@@ -158,6 +219,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "sign-tx",
+				Usage:     "Sign an unsigned transaction built for the node account",
+				UsageText: "stader-cli api wallet sign-tx unsigned-tx-json",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(signTx(c, c.Args().Get(0)))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "purge",
 				Usage:     "Deletes your node wallet, your validator keys, and restarts your Validator Client while preserving your chain data. WARNING: Only use this if you want to stop validating with this machine!",
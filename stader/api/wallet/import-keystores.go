@@ -0,0 +1,76 @@
+package wallet
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+func importKeystores(c *cli.Context, password string, keystores []string) (*api.ImportKeystoresResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ImportKeystoresResponse{
+		Keystores: make([]api.ImportedKeystore, len(keystores)),
+	}
+
+	// Import each keystore; one bad keystore doesn't stop the others
+	imported := 0
+	for i, keystore := range keystores {
+		key, err := w.ImportValidatorKey([]byte(keystore), password)
+		if err != nil {
+			response.Keystores[i].Error = err.Error()
+			continue
+		}
+		response.Keystores[i].Imported = true
+		response.Keystores[i].Pubkey = key.PublicKey
+		response.Keystores[i].DerivationPath = key.DerivationPath
+		imported++
+	}
+
+	// Imported keys are held out of the validator client until their slashing protection history is imported into it
+	released := []types.ValidatorPubkey{}
+	if imported > 0 {
+		var held []types.ValidatorPubkey
+		released, held, err = releaseProtectedKeys(cfg, w)
+		if err != nil {
+			return nil, err
+		}
+		isHeld := map[types.ValidatorPubkey]bool{}
+		for _, pubkey := range held {
+			isHeld[pubkey] = true
+		}
+		response.MissingSlashingProtection = []types.ValidatorPubkey{}
+		for _, keystore := range response.Keystores {
+			if keystore.Imported && isHeld[keystore.Pubkey] {
+				response.MissingSlashingProtection = append(response.MissingSlashingProtection, keystore.Pubkey)
+			}
+		}
+	}
+
+	// Restart the validator client so it loads the released keys
+	if len(released) > 0 {
+		if err := restartValidator(c, cfg); err != nil {
+			return nil, err
+		}
+		response.RestartedValidator = true
+	}
+
+	// Return response
+	return &response, nil
+
+}
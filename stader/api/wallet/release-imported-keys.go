@@ -0,0 +1,111 @@
+package wallet
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/slashing"
+	"github.com/stader-labs/stader-node/shared/utils/validator"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+func releaseImportedKeys(c *cli.Context) (*api.ReleaseImportedKeysResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ReleaseImportedKeysResponse{}
+
+	// Release the keys
+	response.Released, response.Held, err = releaseProtectedKeys(cfg, w)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restart the validator client so it loads the released keys
+	if len(response.Released) > 0 {
+		if err := restartValidator(c, cfg); err != nil {
+			return nil, err
+		}
+		response.RestartedValidator = true
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Store the held imported keys whose slashing protection history is in the selected validator client, or that never signed anything, in the validator keystores.
+// Returns the released keys and the keys still held.
+func releaseProtectedKeys(cfg *config.StaderConfig, w *wallet.Wallet) ([]types.ValidatorPubkey, []types.ValidatorPubkey, error) {
+
+	// Get the held keys
+	importedKeys, err := w.GetImportedValidatorKeys()
+	if err != nil {
+		return nil, nil, err
+	}
+	held := []types.ValidatorPubkey{}
+	for _, key := range importedKeys {
+		if key.Held {
+			held = append(held, key.PublicKey)
+		}
+	}
+	if len(held) == 0 {
+		return []types.ValidatorPubkey{}, held, nil
+	}
+
+	// Slashing protection history is only managed for Docker validator clients
+	unprotected := []types.ValidatorPubkey{}
+	if !cfg.IsNativeMode {
+		client, _ := cfg.GetSelectedConsensusClient()
+		unprotected, err = slashing.GetUnprotectedPubkeys(cfg.StaderNode.GetSlashingProtectionPath(true), client, held)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	isUnprotected := map[types.ValidatorPubkey]bool{}
+	for _, pubkey := range unprotected {
+		isUnprotected[pubkey] = true
+	}
+
+	// Release the protected keys
+	released := []types.ValidatorPubkey{}
+	for _, pubkey := range held {
+		if isUnprotected[pubkey] {
+			continue
+		}
+		if err := w.ReleaseImportedValidatorKey(pubkey); err != nil {
+			return nil, nil, err
+		}
+		released = append(released, pubkey)
+	}
+	return released, unprotected, nil
+
+}
+
+// Restart the validator client
+func restartValidator(c *cli.Context, cfg *config.StaderConfig) error {
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return err
+	}
+	d, err := services.GetDocker(c)
+	if err != nil {
+		return err
+	}
+	return validator.RestartValidator(cfg, bc, nil, d)
+}
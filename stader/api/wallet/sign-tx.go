@@ -0,0 +1,67 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/txs"
+	"github.com/stader-labs/stader-node/shared/types/api"
+)
+
+func signTx(c *cli.Context, unsignedTxJson string) (*api.SignTxResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SignTxResponse{}
+
+	// Get the transaction
+	var unsignedTx txs.UnsignedTransaction
+	if err := json.Unmarshal([]byte(unsignedTxJson), &unsignedTx); err != nil {
+		return nil, fmt.Errorf("could not decode unsigned transaction: %w", err)
+	}
+	tx, err := unsignedTx.ToTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check it was built for this node account and network
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if unsignedTx.From != nodeAccount.Address {
+		return nil, fmt.Errorf("The transaction was built for account %s, but the node account is %s", unsignedTx.From.Hex(), nodeAccount.Address.Hex())
+	}
+	if tx.ChainId().Cmp(w.GetChainID()) != 0 {
+		return nil, fmt.Errorf("The transaction was built for chain %s, but the node wallet is configured for chain %s", tx.ChainId().String(), w.GetChainID().String())
+	}
+
+	// Sign it
+	serializedTx, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("could not serialize transaction: %w", err)
+	}
+	rawTx, err := w.Sign(serializedTx)
+	if err != nil {
+		return nil, err
+	}
+	response.SignedTx, _, err = txs.NewSignedTransaction(rawTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
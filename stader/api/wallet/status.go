@@ -26,6 +26,7 @@ import (
 
 	"github.com/stader-labs/stader-node/shared/services"
 	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/stader-lib/types"
 )
 
 func getStatus(c *cli.Context) (*api.WalletStatusResponse, error) {
@@ -77,6 +78,16 @@ func getStatus(c *cli.Context) (*api.WalletStatusResponse, error) {
 
 		response.PendingNonce = big.NewInt(int64(pendingNonce))
 		response.CurrentNonce = big.NewInt(int64(currentNonce))
+
+		// Get validator keys imported from keystores
+		importedKeys, err := w.GetImportedValidatorKeys()
+		if err != nil {
+			return nil, err
+		}
+		response.ImportedKeys = make([]types.ValidatorPubkey, len(importedKeys))
+		for i, key := range importedKeys {
+			response.ImportedKeys[i] = key.PublicKey
+		}
 	}
 
 	// Return response
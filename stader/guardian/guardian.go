@@ -20,9 +20,13 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package guardian
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -34,10 +38,13 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/shared/utils/scheduler"
 )
 
 // Config
 var tasksInterval, _ = time.ParseDuration("2m")
+var tasksJitter, _ = time.ParseDuration("10s")
+var tasksTimeout, _ = time.ParseDuration("5m")
 var taskCooldown, _ = time.ParseDuration("10s")
 
 const (
@@ -46,6 +53,8 @@ const (
 	ErrorColor   = color.FgRed
 	UpdateColor  = color.FgBlue
 	MetricsColor = color.FgHiYellow
+
+	MetricsTaskName = "metrics"
 )
 
 // Register guardian command
@@ -94,57 +103,61 @@ func run(c *cli.Context) error {
 		return err
 	}
 
-	wg := new(sync.WaitGroup)
-	wg.Add(2)
-
-	// Run metrics loop
-	go func() {
-		m, err := state.NewMetricsCache(c, cfg, ec, bc, &updateLog)
-		if err != nil {
-			panic(err)
-		}
+	m, err := state.NewMetricsCache(c, cfg, ec, bc, &updateLog)
+	if err != nil {
+		return err
+	}
 
-		for {
+	// Register tasks
+	taskScheduler := scheduler.NewScheduler(&errorLog)
+	err = taskScheduler.AddTask(scheduler.Task{
+		Name:          MetricsTaskName,
+		Interval:      tasksInterval,
+		RetryInterval: taskCooldown,
+		Jitter:        tasksJitter,
+		Timeout:       tasksTimeout,
+		Run: func(ctx context.Context) error {
 			// Check the EC status
-			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
+			err := services.WaitEthClientSyncedContext(ctx, c, false) // Force refresh the primary / fallback EC status
 			if err != nil {
-				errorLog.Println("WaitEthClientSynced ", err)
-				time.Sleep(taskCooldown)
-				continue
+				return fmt.Errorf("WaitEthClientSynced: %w", err)
 			}
 
 			// Check the BC status
-			err = services.WaitBeaconClientSynced(c, false) // Force refresh the primary / fallback BC status
+			err = services.WaitBeaconClientSyncedContext(ctx, c, false) // Force refresh the primary / fallback BC status
 			if err != nil {
-				errorLog.Println("WaitBeaconClientSynced ", err)
-				time.Sleep(taskCooldown)
-				continue
+				return fmt.Errorf("WaitBeaconClientSynced: %w", err)
 			}
 
 			networkStateCache, err := updateMetricsCache(m, nodeAccount.Address)
 			if err != nil {
-				errorLog.Println("updateMetricsCache ", err)
-				time.Sleep(taskCooldown)
-				continue
+				return fmt.Errorf("updateMetricsCache: %w", err)
 			}
 			metricsCache.UpdateMetricsContainer(networkStateCache)
-			time.Sleep(tasksInterval)
-		}
+			return nil
+		},
+	})
+	if err != nil {
+		return err
+	}
 
-		wg.Done()
-	}()
+	// Stop scheduling new work when the container is stopped, and let the work in flight finish
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
 	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), metricsCache)
+		defer wg.Done()
+		err := runMetricsServer(ctx, c, log.NewColorLogger(MetricsColor), metricsCache)
 		if err != nil {
 			errorLog.Println(err)
 		}
-		wg.Done()
 	}()
 
+	err = taskScheduler.Run(ctx)
 	wg.Wait()
-
-	return nil
+	return err
 }
 
 // Configure HTTP transport settings
@@ -20,10 +20,12 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package guardian
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/stader-labs/stader-node/stader/guardian/collector"
 
@@ -34,7 +36,10 @@ import (
 	"github.com/urfave/cli"
 )
 
-func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *collector.MetricsCacheContainer) error {
+// Config
+var metricsServerShutdownTimeout, _ = time.ParseDuration("5s")
+
+func runMetricsServer(ctx context.Context, c *cli.Context, logger log.ColorLogger, stateLocker *collector.MetricsCacheContainer) error {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -86,8 +91,9 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 	metricsPort := c.GlobalUint("metricsPort")
 	logger.Printlnf("Starting metrics exporter on %s:%d.", metricsAddress, metricsPort)
 	metricsPath := "/metrics"
-	http.Handle(metricsPath, handler)
-	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, handler)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte(`<html>
             <head><title>Stader Guardian Metrics Exporter</title></head>
             <body>
@@ -97,8 +103,21 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
             </html>`,
 		))
 	})
-	err = http.ListenAndServe(fmt.Sprintf("%s:%d", metricsAddress, metricsPort), nil)
-	if err != nil {
+	server := &http.Server{
+		Addr:    fmt.Sprintf("%s:%d", metricsAddress, metricsPort),
+		Handler: mux,
+	}
+
+	// Stop the server along with the daemon
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), metricsServerShutdownTimeout)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	err = server.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
 		return fmt.Errorf("Error running HTTP server: %w", err)
 	}
 
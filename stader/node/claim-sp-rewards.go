@@ -0,0 +1,194 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	apiutils "github.com/stader-labs/stader-node/shared/utils/api"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/shared/utils/stader"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
+	sd_collateral "github.com/stader-labs/stader-node/stader-lib/sd-collateral"
+	socializing_pool "github.com/stader-labs/stader-node/stader-lib/socializing-pool"
+	staderlib "github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+// Claim socializing pool rewards task
+type claimSpRewards struct {
+	c   *cli.Context
+	log log.ColorLogger
+	cfg *config.StaderConfig
+	w   *wallet.Wallet
+	ec  staderlib.ExecutionClient
+	sp  *staderlib.SocializingPoolContractManager
+	sdc *staderlib.SdCollateralContractManager
+}
+
+// Create claim socializing pool rewards task
+func newClaimSpRewards(c *cli.Context, logger log.ColorLogger) (*claimSpRewards, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	sp, err := services.GetSocializingPoolContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sdc, err := services.GetSdCollateralContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &claimSpRewards{
+		c:   c,
+		log: logger,
+		cfg: cfg,
+		w:   w,
+		ec:  ec,
+		sp:  sp,
+		sdc: sdc,
+	}, nil
+
+}
+
+// Claim all unclaimed cycles with a cached proof once they are worth it and gas is cheap enough
+func (t *claimSpRewards) run(ctx context.Context) error {
+
+	t.log.Println("Checking for socializing pool rewards to claim...")
+
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	rewardCycles, err := stdr.GetSpRewardCycles(t.sp, nodeAccount.Address, nil)
+	if err != nil {
+		return err
+	}
+	if rewardCycles.SocializingPoolContractPaused {
+		t.log.Println("The socializing pool contract is paused, skipping the claim.")
+		return nil
+	}
+
+	// Only claim cycles whose proof is cached and still verifies
+	cycles := []*big.Int{}
+	totalEth := big.NewInt(0)
+	totalSd := big.NewInt(0)
+	for _, cycle := range rewardCycles.UnclaimedCycles {
+		if ctx.Err() != nil {
+			return fmt.Errorf("sp rewards claim interrupted: %w", ctx.Err())
+		}
+
+		proofs, exists, err := t.cfg.StaderNode.ReadCycleCache(cycle.Int64())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+
+		parsed, err := stader.ParseCycleMerkleProofs(&proofs)
+		if err != nil {
+			return fmt.Errorf("error reading the merkle proof for cycle %d: %w", cycle.Int64(), err)
+		}
+		// the socializing pool reverts on empty claims
+		if parsed.AmountEth.Sign() == 0 && parsed.AmountSd.Sign() == 0 {
+			continue
+		}
+
+		err = stader.VerifyCycleMerkleProofs(t.sp, nodeAccount.Address, &proofs)
+		var verificationErr *stader.MerkleProofVerificationError
+		if errors.As(err, &verificationErr) {
+			if err := stader.QuarantineCycleMerkleProofs(t.cfg, &proofs, verificationErr); err != nil {
+				return err
+			}
+			t.log.Printlnf("WARNING: %s. The proof has been quarantined and the cycle will not be claimed.", verificationErr.Error())
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		cycles = append(cycles, cycle)
+		totalEth.Add(totalEth, parsed.AmountEth)
+		totalSd.Add(totalSd, parsed.AmountSd)
+	}
+	if len(cycles) == 0 {
+		t.log.Println("No socializing pool rewards to claim.")
+		return nil
+	}
+
+	// Check the rewards are worth claiming
+	sdInEth, err := sd_collateral.ConvertSdToEth(t.sdc, totalSd, nil)
+	if err != nil {
+		return err
+	}
+	totalValue := new(big.Int).Add(totalEth, sdInEth)
+	minimumValue := eth.EthToWei(t.cfg.StaderNode.AutoClaimSpRewardsMinimum.Value.(float64))
+	if totalValue.Cmp(minimumValue) < 0 {
+		t.log.Printlnf("Claimable rewards of %.6f ETH and %.6f SD (%.6f ETH in total) for cycles %v are below the minimum of %.6f ETH, skipping the claim.",
+			eth.WeiToEth(totalEth), eth.WeiToEth(totalSd), eth.WeiToEth(totalValue), cycles, eth.WeiToEth(minimumValue))
+		return nil
+	}
+
+	// Check the base fee is low enough
+	header, err := t.ec.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error getting the latest block header: %w", err)
+	}
+	maxBaseFeeGwei := t.cfg.StaderNode.AutoClaimSpRewardsMaxBaseFee.Value.(float64)
+	if header.BaseFee != nil && header.BaseFee.Cmp(eth.GweiToWei(maxBaseFeeGwei)) > 0 {
+		t.log.Printlnf("Current base fee of %.2f gwei is above the ceiling of %.2f gwei, postponing the claim for cycles %v.",
+			eth.WeiToGwei(header.BaseFee), maxBaseFeeGwei, cycles)
+		return nil
+	}
+
+	amountSd, amountEth, merkleProofs, err := t.cfg.StaderNode.GetClaimData(cycles)
+	if err != nil {
+		return err
+	}
+
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+	gasInfo, err := socializing_pool.EstimateClaimRewards(t.sp, cycles, amountSd, amountEth, merkleProofs, opts)
+	if err != nil {
+		return fmt.Errorf("error estimating the gas of the claim for cycles %v: %w", cycles, err)
+	}
+	opts.GasLimit = gasInfo.SafeGasLimit
+
+	t.log.Printlnf("Claiming %.6f ETH and %.6f SD of socializing pool rewards for cycles %v...", eth.WeiToEth(totalEth), eth.WeiToEth(totalSd), cycles)
+	tx, err := socializing_pool.ClaimRewards(t.sp, cycles, amountSd, amountEth, merkleProofs, opts)
+	if err != nil {
+		return fmt.Errorf("error claiming rewards for cycles %v: %w", cycles, err)
+	}
+
+	err = apiutils.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.ec, t.log)
+	if err != nil {
+		return err
+	}
+
+	t.log.Printlnf("Successfully claimed socializing pool rewards for cycles %v in transaction %s.", cycles, tx.Hash().Hex())
+	return nil
+
+}
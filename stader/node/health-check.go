@@ -0,0 +1,53 @@
+package node
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/urfave/cli"
+)
+
+// Config
+var healthCheckTimeout, _ = time.ParseDuration("5s")
+
+const (
+	healthServerEnabledEnvVar = "ENABLE_NODE_HEALTH_SERVER"
+	healthServerPortEnvVar    = "NODE_HEALTH_PORT"
+)
+
+// Register the health check command, used as the node container's healthcheck since the image has no HTTP client
+func RegisterHealthCheckCommand(app *cli.App, name string, aliases []string) {
+	app.Commands = append(app.Commands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Probe the node daemon's /healthz endpoint, exiting with an error if it is unhealthy",
+		Action: func(c *cli.Context) error {
+			return checkHealth()
+		},
+	})
+}
+
+// Probe the health endpoint of the daemon running in this container.
+// The check passes when the health server is disabled, since there is nothing to probe.
+func checkHealth() error {
+	if os.Getenv(healthServerEnabledEnvVar) != "true" {
+		return nil
+	}
+	port := os.Getenv(healthServerPortEnvVar)
+	if port == "" {
+		return fmt.Errorf("%s is not set", healthServerPortEnvVar)
+	}
+
+	client := http.Client{Timeout: healthCheckTimeout}
+	response, err := client.Get(fmt.Sprintf("http://127.0.0.1:%s/healthz", port))
+	if err != nil {
+		return fmt.Errorf("error probing the health server: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("the node daemon is unhealthy (status %d)", response.StatusCode)
+	}
+	return nil
+}
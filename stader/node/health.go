@@ -0,0 +1,275 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/shared/utils/scheduler"
+)
+
+// Config
+var clientStatusInterval, _ = time.ParseDuration("1m")
+var clientStatusTimeout, _ = time.ParseDuration("1m")
+var healthServerShutdownTimeout, _ = time.ParseDuration("5s")
+
+const (
+	ClientStatusTaskName = "client-status"
+
+	ClientInUse_Primary  = "primary"
+	ClientInUse_Fallback = "fallback"
+	ClientInUse_None     = "none"
+)
+
+// The health of a single daemon task
+type taskHealth struct {
+	scheduler.TaskStatus
+	Deadline time.Time `json:"deadline"`
+	Healthy  bool      `json:"healthy"`
+}
+
+// The state of an EC or BC manager
+type clientHealth struct {
+	Synced       bool                     `json:"synced"`
+	LastSyncErr  string                   `json:"lastSyncError"`
+	LastChecked  time.Time                `json:"lastChecked"`
+	InUse        string                   `json:"inUse"`
+	ManagerState *api.ClientManagerStatus `json:"managerStatus"`
+}
+
+// The report served by the health and readiness endpoints
+type healthReport struct {
+	Healthy      bool         `json:"healthy"`
+	Ready        bool         `json:"ready"`
+	StartedAt    time.Time    `json:"startedAt"`
+	WalletLoaded bool         `json:"walletLoaded"`
+	Ec           clientHealth `json:"ec"`
+	Bc           clientHealth `json:"bc"`
+	Tasks        []taskHealth `json:"tasks"`
+}
+
+// Tracks the state of the node daemon for the health and readiness endpoints
+type healthMonitor struct {
+	c         *cli.Context
+	cfg       *config.StaderConfig
+	w         *wallet.Wallet
+	ec        *services.ExecutionClientManager
+	bc        *services.BeaconClientManager
+	scheduler *scheduler.Scheduler
+	tasks     map[string]scheduler.Task
+	window    time.Duration
+	startTime time.Time
+	ecHealth  clientHealth
+	bcHealth  clientHealth
+	lock      sync.Mutex
+}
+
+// Create a health monitor
+func newHealthMonitor(c *cli.Context, taskScheduler *scheduler.Scheduler) (*healthMonitor, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	window := time.Duration(cfg.StaderNode.HealthCheckWindow.Value.(uint64)) * time.Minute
+
+	// Return
+	return &healthMonitor{
+		c:         c,
+		cfg:       cfg,
+		w:         w,
+		ec:        ec,
+		bc:        bc,
+		scheduler: taskScheduler,
+		tasks:     map[string]scheduler.Task{},
+		window:    window,
+		startTime: time.Now(),
+		ecHealth:  clientHealth{InUse: ClientInUse_None},
+		bcHealth:  clientHealth{InUse: ClientInUse_None},
+	}, nil
+
+}
+
+// Track a scheduled task; it is unhealthy if it hasn't succeeded within its interval plus the health check window
+func (m *healthMonitor) watchTask(task scheduler.Task) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.tasks[task.Name] = task
+}
+
+// Record the outcome of waiting for the EC and BC to sync
+func (m *healthMonitor) recordClientSync(ecErr error, bcErr error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+	m.ecHealth.Synced = ecErr == nil
+	m.ecHealth.LastSyncErr = errorString(ecErr)
+	m.ecHealth.LastChecked = now
+
+	// The BC isn't checked unless the EC is synced
+	if ecErr != nil {
+		return
+	}
+	m.bcHealth.Synced = bcErr == nil
+	m.bcHealth.LastSyncErr = errorString(bcErr)
+	m.bcHealth.LastChecked = now
+}
+
+// Refresh the primary / fallback status of the EC and BC managers
+func (m *healthMonitor) refreshClientStatus(ctx context.Context) error {
+	ecStatus := m.ec.CheckStatus(m.cfg)
+	bcStatus := m.bc.CheckStatus()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.ecHealth.ManagerState = ecStatus
+	m.ecHealth.InUse = getClientInUse(ecStatus)
+	m.bcHealth.ManagerState = bcStatus
+	m.bcHealth.InUse = getClientInUse(bcStatus)
+
+	if m.ecHealth.InUse == ClientInUse_None {
+		return fmt.Errorf("neither the primary nor the fallback execution client is usable")
+	}
+	if m.bcHealth.InUse == ClientInUse_None {
+		return fmt.Errorf("neither the primary nor the fallback beacon client is usable")
+	}
+	return nil
+}
+
+// Build the current health report
+func (m *healthMonitor) getReport() healthReport {
+	statuses := m.scheduler.GetTaskStatuses()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+	report := healthReport{
+		Healthy:      true,
+		StartedAt:    m.startTime,
+		WalletLoaded: m.w.IsInitialized(),
+		Ec:           m.ecHealth,
+		Bc:           m.bcHealth,
+		Tasks:        make([]taskHealth, 0, len(statuses)),
+	}
+
+	for _, status := range statuses {
+		task, watched := m.tasks[status.Name]
+		if !watched {
+			continue
+		}
+
+		// Tasks that never succeeded are measured from the daemon start
+		since := status.LastSuccess
+		if since.IsZero() {
+			since = m.startTime
+		}
+		deadline := since.Add(task.Interval + task.Jitter + task.Timeout + m.window)
+		health := taskHealth{
+			TaskStatus: status,
+			Deadline:   deadline,
+			Healthy:    now.Before(deadline),
+		}
+		if !health.Healthy {
+			report.Healthy = false
+		}
+		report.Tasks = append(report.Tasks, health)
+	}
+
+	report.Ready = report.WalletLoaded &&
+		m.ecHealth.Synced && m.bcHealth.Synced &&
+		m.ecHealth.InUse != ClientInUse_None && m.bcHealth.InUse != ClientInUse_None
+
+	return report
+}
+
+// Serve the health and readiness endpoints until the context is cancelled
+func (m *healthMonitor) serve(ctx context.Context, logger log.ColorLogger) error {
+
+	address := fmt.Sprintf("%s:%d", m.c.GlobalString("metricsAddress"), m.cfg.StaderNode.HealthServerPort.Value.(uint16))
+	logger.Printlnf("Starting health server on %s.", address)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		report := m.getReport()
+		writeReport(w, report, report.Healthy)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		report := m.getReport()
+		writeReport(w, report, report.Ready)
+	})
+	server := &http.Server{
+		Addr:    address,
+		Handler: mux,
+	}
+
+	// Stop the server along with the daemon
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), healthServerShutdownTimeout)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	err := server.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("Error running health server: %w", err)
+	}
+
+	return nil
+
+}
+
+// Write a health report as JSON
+func writeReport(w http.ResponseWriter, report healthReport, ok bool) {
+	w.Header().Set("Content-Type", "application/json")
+	if ok {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(report)
+}
+
+// Get which client of a manager is currently usable
+func getClientInUse(status *api.ClientManagerStatus) string {
+	if status.PrimaryClientStatus.IsWorking && status.PrimaryClientStatus.IsSynced {
+		return ClientInUse_Primary
+	}
+	if status.FallbackEnabled && status.FallbackClientStatus.IsWorking && status.FallbackClientStatus.IsSynced {
+		return ClientInUse_Fallback
+	}
+	return ClientInUse_None
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
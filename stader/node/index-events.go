@@ -0,0 +1,129 @@
+package node
+
+import (
+	"context"
+	"os"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/events"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+// Index events task
+type indexEvents struct {
+	c         *cli.Context
+	log       log.ColorLogger
+	cfg       *config.StaderConfig
+	w         *wallet.Wallet
+	ec        stader.ExecutionClient
+	contracts events.Contracts
+}
+
+// Create index events task
+func newIndexEvents(c *cli.Context, logger log.ColorLogger) (*indexEvents, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	pp, err := services.GetPermissionlessPoolContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sdc, err := services.GetSdCollateralContract(c)
+	if err != nil {
+		return nil, err
+	}
+	orc, err := services.GetOperatorRewardsCollectorContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sp, err := services.GetSocializingPoolContract(c)
+	if err != nil {
+		return nil, err
+	}
+	pt, err := services.GetPenaltyTrackerContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &indexEvents{
+		c:   c,
+		log: logger,
+		cfg: cfg,
+		w:   w,
+		ec:  ec,
+		contracts: events.Contracts{
+			Pnr: pnr,
+			Pp:  pp,
+			Sdc: sdc,
+			Orc: orc,
+			Sp:  sp,
+			Pt:  pt,
+		},
+	}, nil
+
+}
+
+// Index the operator's events since the last run.
+// The index is locked while it is open, so it is only held for a limited time per run to let the API read it in between.
+func (t *indexEvents) run(ctx context.Context) error {
+
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	logInterval, err := t.cfg.GetEventLogInterval()
+	if err != nil {
+		return err
+	}
+
+	store, err := events.OpenStore(os.ExpandEnv(t.cfg.StaderNode.GetEventIndexPath(true)), false)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	indexer, err := events.NewIndexer(store, t.ec, t.contracts, nodeAccount.Address, logInterval)
+	if err != nil {
+		return err
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, indexEventsRunBudget)
+	defer cancel()
+	progress, err := indexer.Run(runCtx)
+	if err != nil {
+		return err
+	}
+
+	if !progress.Onboarded {
+		t.log.Printlnf("Searching for the block the operator was onboarded in: reached block %d of %d.", progress.LastBlock, progress.TargetBlock)
+		return nil
+	}
+	if progress.LastBlock < progress.TargetBlock {
+		t.log.Printlnf("Indexed %d new events, reached block %d of %d (indexing from block %d). Indexing will continue on the next run.", progress.NewEvents, progress.LastBlock, progress.TargetBlock, progress.StartBlock)
+	} else if progress.NewEvents > 0 {
+		t.log.Printlnf("Indexed %d new events up to block %d.", progress.NewEvents, progress.LastBlock)
+	}
+	return nil
+
+}
@@ -20,9 +20,11 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package node
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/types"
 
 	"github.com/docker/docker/client"
 	"github.com/ethereum/go-ethereum/common"
@@ -106,10 +108,10 @@ func newManageFeeRecipient(c *cli.Context, logger log.ColorLogger) (*manageFeeRe
 }
 
 // Manage fee recipient
-func (m *manageFeeRecipient) run() error {
+func (m *manageFeeRecipient) run(ctx context.Context) error {
 
 	// Wait for eth client to sync
-	if err := services.WaitEthClientSynced(m.c, true); err != nil {
+	if err := services.WaitEthClientSyncedContext(ctx, m.c, true); err != nil {
 		return err
 	}
 
@@ -139,27 +141,48 @@ func (m *manageFeeRecipient) run() error {
 		return fmt.Errorf("error validating fee recipient files: %w", err)
 	}
 
+	fileUpdated := false
 	if !fileExists {
 		m.log.Println("Fee recipient files don't all exist, regenerating...")
 	} else if !correctAddress {
 		m.log.Printlnf("WARNING: Fee recipient files did not contain the correct fee recipient of %s, regenerating...", correctFeeRecipient.Hex())
-	} else {
-		// Files are all correct, return.
-		m.log.Printlnf("Fee recipient files are all correct, no action required.")
-		return nil
 	}
-
-	// Regenerate the fee recipient files
-	err = staderService.UpdateFeeRecipientFile(correctFeeRecipient, m.cfg)
-	if err != nil {
-		m.log.Println("***ERROR***")
-		m.log.Printlnf("Error updating fee recipient files: %s", err.Error())
-		m.log.Println("Shutting down the validator client for safety to prevent you from being penalized...")
-
-		err = validator.StopValidator(m.cfg, m.bc, &m.log, m.d)
+	if !fileExists || !correctAddress {
+		// Regenerate the fee recipient files
+		err = staderService.UpdateFeeRecipientFile(correctFeeRecipient, m.cfg)
 		if err != nil {
-			return fmt.Errorf("error stopping validator client: %w", err)
+			m.log.Println("***ERROR***")
+			m.log.Printlnf("Error updating fee recipient files: %s", err.Error())
+			m.log.Println("Shutting down the validator client for safety to prevent you from being penalized...")
+
+			err = validator.StopValidator(m.cfg, m.bc, &m.log, m.d)
+			if err != nil {
+				return fmt.Errorf("error stopping validator client: %w", err)
+			}
+			return nil
 		}
+		fileUpdated = true
+	}
+
+	// Update the running VC through its Keymanager API so it doesn't need a restart
+	if !m.cfg.IsNativeMode && m.cfg.EnableKeymanagerApi.Value == true {
+		updatedCount, err := m.updateKeymanagerFeeRecipients(ctx, correctFeeRecipient)
+		if err == nil {
+			if updatedCount > 0 {
+				m.log.Printlnf("Updated the fee recipient of %d validator(s) to %s through the validator client's Keymanager API.", updatedCount, correctFeeRecipient.Hex())
+			} else if fileUpdated {
+				m.log.Println("Fee recipient files updated successfully! The validator client is already using the correct fee recipient.")
+			} else {
+				m.log.Printlnf("Fee recipient files are all correct, no action required.")
+			}
+			return nil
+		}
+		m.log.Printlnf("WARNING: Could not update the fee recipient through the validator client's Keymanager API: %s", err.Error())
+	}
+
+	if !fileUpdated {
+		// Files are all correct, return.
+		m.log.Printlnf("Fee recipient files are all correct, no action required.")
 		return nil
 	}
 
@@ -175,3 +198,53 @@ func (m *manageFeeRecipient) run() error {
 	return nil
 
 }
+
+// Set the fee recipient of every validator loaded by the VC that doesn't already use the correct one, returning how many were updated
+func (m *manageFeeRecipient) updateKeymanagerFeeRecipients(ctx context.Context, correctFeeRecipient common.Address) (int, error) {
+
+	km, err := services.GetKeymanagerClient(m.c)
+	if err != nil {
+		return 0, err
+	}
+	keystores, err := km.ListKeystores()
+	if err != nil {
+		return 0, err
+	}
+	pubkeys := make([]types.ValidatorPubkey, 0, len(keystores))
+	for _, keystore := range keystores {
+		pubkeys = append(pubkeys, keystore.Pubkey)
+	}
+
+	// Keys held by the Web3Signer are remote keys of the VC
+	if m.cfg.EnableWeb3Signer.Value == true {
+		remoteKeys, err := km.ListRemoteKeys()
+		if err != nil {
+			return 0, err
+		}
+		for _, remoteKey := range remoteKeys {
+			pubkeys = append(pubkeys, remoteKey.Pubkey)
+		}
+	}
+
+	updatedCount := 0
+	for _, pubkey := range pubkeys {
+		if ctx.Err() != nil {
+			return updatedCount, fmt.Errorf("fee recipient update interrupted: %w", ctx.Err())
+		}
+		feeRecipient, err := km.GetFeeRecipient(pubkey)
+		if err != nil {
+			return updatedCount, err
+		}
+		if feeRecipient == correctFeeRecipient {
+			continue
+		}
+		m.log.Printlnf("WARNING: Validator %s is using fee recipient %s instead of %s, updating it...", pubkey.Hex(), feeRecipient.Hex(), correctFeeRecipient.Hex())
+		if err := km.SetFeeRecipient(pubkey, correctFeeRecipient); err != nil {
+			return updatedCount, err
+		}
+		updatedCount++
+	}
+
+	return updatedCount, nil
+
+}
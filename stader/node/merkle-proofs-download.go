@@ -1,14 +1,15 @@
 package node
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
-	"github.com/mitchellh/go-homedir"
 	"github.com/stader-labs/stader-node/shared/services"
 	"github.com/stader-labs/stader-node/shared/services/config"
 	"github.com/stader-labs/stader-node/shared/services/wallet"
 	"github.com/stader-labs/stader-node/shared/utils/log"
 	"github.com/stader-labs/stader-node/shared/utils/stader"
+	staderlib "github.com/stader-labs/stader-node/stader-lib/stader"
 	"github.com/urfave/cli"
 	"os"
 )
@@ -18,6 +19,7 @@ type MerkleProofsDownloader struct {
 	log log.ColorLogger
 	cfg *config.StaderConfig
 	w   *wallet.Wallet
+	sp  *staderlib.SocializingPoolContractManager
 }
 
 func NewMerkleProofsDownloader(c *cli.Context, logger log.ColorLogger) (*MerkleProofsDownloader, error) {
@@ -29,18 +31,23 @@ func NewMerkleProofsDownloader(c *cli.Context, logger log.ColorLogger) (*MerkleP
 	if err != nil {
 		return nil, err
 	}
+	sp, err := services.GetSocializingPoolContract(c)
+	if err != nil {
+		return nil, err
+	}
 
 	return &MerkleProofsDownloader{
 		c:   c,
 		log: logger,
 		cfg: cfg,
 		w:   w,
+		sp:  sp,
 	}, nil
 }
 
-func (m *MerkleProofsDownloader) run() error {
+func (m *MerkleProofsDownloader) run(ctx context.Context) error {
 	// Wait for eth client to sync
-	if err := services.WaitEthClientSynced(m.c, true); err != nil {
+	if err := services.WaitEthClientSyncedContext(ctx, m.c, true); err != nil {
 		return err
 	}
 
@@ -55,14 +62,15 @@ func (m *MerkleProofsDownloader) run() error {
 	}
 
 	downloadedCycles := []int64{}
+	quarantinedCycles := []int64{}
 
 	for _, cycleMerkleProof := range allMerkleProofs {
-		cycleMerkleProofFile := m.cfg.StaderNode.GetSpRewardCyclePath(cycleMerkleProof.Cycle, true)
-		absolutePathOfProofFile, err := homedir.Expand(cycleMerkleProofFile)
-		if err != nil {
-			return err
+		if ctx.Err() != nil {
+			return fmt.Errorf("merkle proof download interrupted: %w", ctx.Err())
 		}
 
+		cycleMerkleProofFile := m.cfg.StaderNode.GetSpRewardCyclePath(cycleMerkleProof.Cycle, true)
+
 		_, err = os.Stat(cycleMerkleProofFile)
 		if !os.IsNotExist(err) && err != nil {
 			return err
@@ -73,20 +81,33 @@ func (m *MerkleProofsDownloader) run() error {
 		}
 
 		m.log.Printlnf("Downloading merkle proof for cycle %d", cycleMerkleProof.Cycle)
-		file, err := os.Create(absolutePathOfProofFile)
+		err = stader.VerifyCycleMerkleProofs(m.sp, nodeAccount.Address, cycleMerkleProof)
+		var verificationErr *stader.MerkleProofVerificationError
+		if errors.As(err, &verificationErr) {
+			err = stader.QuarantineCycleMerkleProofs(m.cfg, cycleMerkleProof, verificationErr)
+			if err != nil {
+				return err
+			}
+			m.log.Printlnf("WARNING: %s. The proof has been quarantined and will not be used for claims.", verificationErr.Error())
+			quarantinedCycles = append(quarantinedCycles, cycleMerkleProof.Cycle)
+			continue
+		}
 		if err != nil {
 			return err
 		}
 
-		encoder := json.NewEncoder(file)
-		err = encoder.Encode(cycleMerkleProof)
+		err = stader.SaveCycleMerkleProofs(m.cfg, cycleMerkleProof)
 		if err != nil {
-			return fmt.Errorf("error encoding JSON: %v", err)
+			return err
 		}
 
 		downloadedCycles = append(downloadedCycles, cycleMerkleProof.Cycle)
 	}
 
+	if len(quarantinedCycles) > 0 {
+		m.log.Printlnf("Quarantined merkle proofs for cycles: %v", quarantinedCycles)
+	}
+
 	if len(downloadedCycles) == 0 {
 		m.log.Printlnf("No merkle proofs to download")
 		return nil
@@ -20,45 +20,72 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package node
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
-	"strconv"
 	"sync"
+	"syscall"
 	"time"
 
-	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
-	"github.com/stader-labs/stader-node/shared/utils/crypto"
-	"github.com/stader-labs/stader-node/shared/utils/eth2"
-	"github.com/stader-labs/stader-node/shared/utils/stader"
-	"github.com/stader-labs/stader-node/shared/utils/stdr"
-	"github.com/stader-labs/stader-node/shared/utils/validator"
-	"github.com/stader-labs/stader-node/stader-lib/node"
-	eth2types "github.com/wealdtech/go-eth2-types/v2"
-
 	"github.com/fatih/color"
 	"github.com/urfave/cli"
 
 	"github.com/stader-labs/stader-node/shared/services"
 	"github.com/stader-labs/stader-node/shared/services/config"
+	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
 	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/shared/utils/scheduler"
 )
 
 // Config
 var preSignedCooldown, _ = time.ParseDuration("1h")
+var preSignedJitter, _ = time.ParseDuration("5m")
+var preSignedTimeout, _ = time.ParseDuration("30m")
 var feeRecepientPollingInterval, _ = time.ParseDuration("5m")
-var taskCooldown, _ = time.ParseDuration("10s")
+var feeRecipientJitter, _ = time.ParseDuration("30s")
+var feeRecipientTimeout, _ = time.ParseDuration("5m")
 var merkleProofsDownloadInterval, _ = time.ParseDuration("3h")
+var merkleProofsDownloadJitter, _ = time.ParseDuration("10m")
+var merkleProofsDownloadTimeout, _ = time.ParseDuration("10m")
+var claimSpRewardsInterval, _ = time.ParseDuration("6h")
+var claimSpRewardsJitter, _ = time.ParseDuration("15m")
+var claimSpRewardsTimeout, _ = time.ParseDuration("30m")
+var sweepRewardsInterval, _ = time.ParseDuration("6h")
+var sweepRewardsJitter, _ = time.ParseDuration("15m")
+var sweepRewardsTimeout, _ = time.ParseDuration("30m")
+var sendClRewardsInterval, _ = time.ParseDuration("24h")
+var sendClRewardsJitter, _ = time.ParseDuration("30m")
+var sendClRewardsTimeout, _ = time.ParseDuration("1h")
+var indexEventsInterval, _ = time.ParseDuration("1m")
+var indexEventsJitter, _ = time.ParseDuration("10s")
+var indexEventsTimeout, _ = time.ParseDuration("5m")
+var indexEventsRunBudget, _ = time.ParseDuration("30s")
+var taskRetryInterval, _ = time.ParseDuration("1m")
 
 const (
 	MaxConcurrentEth1Requests   = 200
 	ManageFeeRecipientColor     = color.FgHiCyan
 	MerkleProofsDownloaderColor = color.FgHiBlue
+	ClaimSpRewardsColor         = color.FgHiMagenta
+	SweepRewardsColor           = color.FgHiYellow
+	SendClRewardsColor          = color.FgYellow
+	ExitWatcherColor            = color.FgHiRed
+	IndexEventsColor            = color.FgCyan
 	ErrorColor                  = color.FgRed
 	InfoColor                   = color.FgHiGreen
+
+	PresignTaskName        = "presign"
+	FeeRecipientTaskName   = "fee-recipient"
+	MerkleProofsTaskName   = "merkle-proofs"
+	ClaimSpRewardsTaskName = "claim-sp-rewards"
+	SweepRewardsTaskName   = "sweep-rewards"
+	SendClRewardsTaskName  = "send-cl-rewards"
+	IndexEventsTaskName    = "index-events"
 )
 
 // Register node command
@@ -85,6 +112,11 @@ func run(c *cli.Context) error {
 	// Configure
 	configureHTTP()
 
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+
 	w, err := services.GetWallet(c)
 	if err != nil {
 		return err
@@ -103,280 +135,245 @@ func run(c *cli.Context) error {
 		return err
 	}
 
-	bc, err := services.GetBeaconClient(c)
+	// Initialize loggers
+	errorLog := log.NewColorLogger(ErrorColor)
+	infoLog := log.NewColorLogger(InfoColor)
+
+	// Initialize tasks
+	submitPresignedExits, err := newSubmitPresignedExits(c, infoLog, errorLog)
 	if err != nil {
 		return err
 	}
-	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	manageFeeRecipient, err := newManageFeeRecipient(c, log.NewColorLogger(ManageFeeRecipientColor))
 	if err != nil {
 		return err
 	}
-
-	// Initialize tasks
-	manageFeeRecipient, err := newManageFeeRecipient(c, log.NewColorLogger(ManageFeeRecipientColor))
+	merkleProofsDownloader, err := NewMerkleProofsDownloader(c, log.NewColorLogger(MerkleProofsDownloaderColor))
 	if err != nil {
 		return err
 	}
-	merkleProofsDownloader, err := NewMerkleProofsDownloader(c, log.NewColorLogger(MerkleProofsDownloaderColor))
+	exitWatcher, err := newExitWatcher(c, log.NewColorLogger(ExitWatcherColor))
 	if err != nil {
 		return err
 	}
 
-	// Initialize loggers
-	errorLog := log.NewColorLogger(ErrorColor)
-	infoLog := log.NewColorLogger(InfoColor)
-
-	publicKey, err := stader.GetPublicKey(c)
+	// Register tasks
+	taskScheduler := scheduler.NewScheduler(&errorLog)
+	monitor, err := newHealthMonitor(c, taskScheduler)
 	if err != nil {
 		return err
 	}
-
-	// Wait group to handle the various threads
-	wg := new(sync.WaitGroup)
-	wg.Add(3)
-
-	// validator presigned loop
-	go func() {
-		for {
-			// Check the EC status
-			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
-			if err != nil {
-				errorLog.Println(err)
-				continue
-			} else {
-				// Check the BC status
-				err := services.WaitBeaconClientSynced(c, false) // Force refresh the primary / fallback BC status
-				if err != nil {
-					errorLog.Println(err)
-					continue
+	tasks := []scheduler.Task{
+		{
+			Name:          PresignTaskName,
+			Interval:      preSignedCooldown,
+			RetryInterval: taskRetryInterval,
+			Jitter:        preSignedJitter,
+			Timeout:       preSignedTimeout,
+			Run: func(ctx context.Context) error {
+				if err := waitClientsSynced(ctx, c, monitor); err != nil {
+					return err
 				}
-			}
-
-			operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, nil)
-			if err != nil {
-				errorLog.Printf("Failed to get operator id: %s\n", err.Error())
-				continue
-			}
-
-			// make a map of all validators actually registered with stader
-			// user might just move the validator keys to the directory. we don't wanna send the presigned msg of them
-			infoLog.Println("Building a map of user validators registered with stader")
-			registeredValidators, validatorPubKeys, err := stdr.GetAllValidatorsRegisteredWithOperator(pnr, operatorId, nodeAccount.Address, nil)
-			if err != nil {
-				errorLog.Printf("Could not get all validators registered with operator %s with error %s\n", operatorId, err.Error())
-				continue
-			}
-
-			infoLog.Printlnf("Found %d validators registered with operator %s", len(registeredValidators), operatorId)
-			infoLog.Println("Starting a pass of the presign daemon!")
-
-			currentHead, err := bc.GetBeaconHead()
-			if err != nil {
-				errorLog.Printf("Could not get beacon head with error %s\n", err.Error())
-				continue
-			}
-
-			err = w.Reload()
-			if err != nil {
-				errorLog.Printf("Could not reload wallet: %s\n", err.Error())
-				continue
-			}
-
-			preSignRegisteredMap, err := stader.BulkIsPresignedKeyRegistered(c, validatorPubKeys)
-			if err != nil {
-				errorLog.Printf("Could not bulk check presigned keys with error %s\n", err.Error())
-				continue
-			}
-
-			pageNumber := 0
-			pageSize := 5
-			for {
-				startIndex := pageNumber * pageSize
-				if startIndex > len(validatorPubKeys) {
-					break
+				return submitPresignedExits.run(ctx)
+			},
+		},
+		{
+			Name:          FeeRecipientTaskName,
+			Interval:      feeRecepientPollingInterval,
+			RetryInterval: taskRetryInterval,
+			Jitter:        feeRecipientJitter,
+			Timeout:       feeRecipientTimeout,
+			Run: func(ctx context.Context) error {
+				if err := waitClientsSynced(ctx, c, monitor); err != nil {
+					return err
+				}
+				// Manage the fee recipient for the node
+				return manageFeeRecipient.run(ctx)
+			},
+		},
+		{
+			Name:          MerkleProofsTaskName,
+			Interval:      merkleProofsDownloadInterval,
+			RetryInterval: taskRetryInterval,
+			Jitter:        merkleProofsDownloadJitter,
+			Timeout:       merkleProofsDownloadTimeout,
+			Run: func(ctx context.Context) error {
+				infoLog.Printlnf("Checking if there are any available merkle proofs to download")
+				if err := waitClientsSynced(ctx, c, monitor); err != nil {
+					return err
 				}
-				endIndex := (pageNumber + 1) * pageSize
-				if endIndex > len(validatorPubKeys) {
-					endIndex = len(validatorPubKeys)
+				if err := merkleProofsDownloader.run(ctx); err != nil {
+					return err
+				}
+				infoLog.Printlnf("Done checking for merkle proofs to download")
+				return nil
+			},
+		},
+	}
+	for _, task := range tasks {
+		if err := taskScheduler.AddTask(task); err != nil {
+			return err
+		}
+		monitor.watchTask(task)
+	}
+
+	if cfg.StaderNode.EnableAutoClaimSpRewards.Value == true {
+		claimSpRewards, err := newClaimSpRewards(c, log.NewColorLogger(ClaimSpRewardsColor))
+		if err != nil {
+			return err
+		}
+		task := scheduler.Task{
+			Name:          ClaimSpRewardsTaskName,
+			Interval:      claimSpRewardsInterval,
+			RetryInterval: taskRetryInterval,
+			Jitter:        claimSpRewardsJitter,
+			Timeout:       claimSpRewardsTimeout,
+			Run: func(ctx context.Context) error {
+				if err := waitClientsSynced(ctx, c, monitor); err != nil {
+					return err
 				}
-				infoLog.Printf("Starting index: %d, End index: %d\n", startIndex, endIndex)
-
-				validatorKeyBatch := validatorPubKeys[startIndex:endIndex]
-				infoLog.Printf("Checking %d validator keys\n", len(validatorKeyBatch))
-
-				preSignSendMessages := []stader_backend.PreSignSendApiRequestType{}
-
-				for _, validatorPubKey := range validatorKeyBatch {
-					infoLog.Printf("Checking validator pubkey %s\n", validatorPubKey.String())
-					validatorKeyPair, err := w.GetValidatorKeyByPubkey(validatorPubKey)
-					// log the errors and continue. dont need to sleep post an error
-					if err != nil {
-						errorLog.Printf("Could not find validator private key for %s with err: %s\n", validatorPubKey, err.Error())
-						continue
-					}
-
-					validatorInfo, ok := registeredValidators[validatorPubKey]
-					if !ok {
-						errorLog.Printf("Validator pub key: %s not found in stader contracts\n", validatorPubKey)
-						continue
-					}
-					if stdr.IsValidatorTerminal(validatorInfo) {
-						errorLog.Printf("Validator pub key: %s is in terminal state in the stader contracts\n", validatorPubKey)
-						continue
-					}
-
-					registeredPresign, ok := preSignRegisteredMap[validatorPubKey.String()]
-					if !ok {
-						errorLog.Printf("Could not query presign api to check if validator: %s is registered\n", validatorPubKey)
-						continue
-					}
-					if registeredPresign {
-						infoLog.Printf("Validator pub key: %s pre signed key already registered\n", validatorPubKey)
-						continue
-					} else {
-						infoLog.Printf("Validator pub key: %s pre signed key not registered. Creating presigned message\n", validatorPubKey)
-					}
-
-					// check if validator has not yet been registered on beacon chain
-					validatorStatus, err := bc.GetValidatorStatus(validatorPubKey, nil)
-					if err != nil {
-						errorLog.Printf("Error finding validator status for validator: %s with err: %s\n", validatorPubKey, err.Error())
-						continue
-					}
-					if !validatorStatus.Exists {
-						errorLog.Printf("Validator pub key: %s not found on beacon chain\n", validatorPubKey)
-						continue
-					}
-
-					// check if validator is already in an exiting phase, then no point sending a pre-signed message
-					if eth2.IsValidatorExiting(validatorStatus) {
-						errorLog.Printf("Validator pub key: %s already exiting or exited with status %s", validatorPubKey, validatorStatus.Status)
-						continue
-					}
-
-					exitEpoch := currentHead.Epoch
-
-					signatureDomain, err := bc.GetDomainData(eth2types.DomainVoluntaryExit[:], exitEpoch, false)
-					if err != nil {
-						errorLog.Printf("Failed to get the signature domain from beacon chain with err: %s\n", err.Error())
-						continue
-					}
-
-					// get the presigned msg
-					exitSignature, _, err := validator.GetSignedExitMessage(validatorKeyPair, validatorStatus.Index, exitEpoch, signatureDomain)
-					if err != nil {
-						errorLog.Printf("Failed to generate the SignedExitMessage for validator with beacon chain index: %d with err: %s\n", validatorStatus.Index, err.Error())
-						continue
-					}
-
-					// encrypt the signature and srHash
-					exitSignatureEncrypted, err := crypto.EncryptUsingPublicKey([]byte(exitSignature.String()), publicKey)
-					if err != nil {
-						errorLog.Printf("Failed to encrypt exit signature for validator: %s with err: %s\n", validatorPubKey, err.Error())
-						continue
-					}
-					exitSignatureEncryptedString := crypto.EncodeBase64(exitSignatureEncrypted)
-
-					// send it to the presigned api
-					preSignSendMessages = append(preSignSendMessages, stader_backend.PreSignSendApiRequestType{
-						Message: struct {
-							Epoch          string `json:"epoch"`
-							ValidatorIndex string `json:"validator_index"`
-						}{
-							Epoch:          strconv.FormatUint(exitEpoch, 10),
-							ValidatorIndex: strconv.FormatUint(validatorStatus.Index, 10),
-						},
-						Signature:          exitSignatureEncryptedString,
-						ValidatorPublicKey: validatorPubKey.String(),
-					})
+				return claimSpRewards.run(ctx)
+			},
+		}
+		if err := taskScheduler.AddTask(task); err != nil {
+			return err
+		}
+		monitor.watchTask(task)
+	}
+
+	if cfg.StaderNode.AutoSweepMode.Value.(cfgtypes.AutoSweepMode) != cfgtypes.AutoSweepMode_Disabled {
+		sweepRewards, err := newSweepRewards(c, log.NewColorLogger(SweepRewardsColor))
+		if err != nil {
+			return err
+		}
+		task := scheduler.Task{
+			Name:          SweepRewardsTaskName,
+			Interval:      sweepRewardsInterval,
+			RetryInterval: taskRetryInterval,
+			Jitter:        sweepRewardsJitter,
+			Timeout:       sweepRewardsTimeout,
+			Run: func(ctx context.Context) error {
+				if err := waitClientsSynced(ctx, c, monitor); err != nil {
+					return err
 				}
+				return sweepRewards.run(ctx)
+			},
+		}
+		if err := taskScheduler.AddTask(task); err != nil {
+			return err
+		}
+		monitor.watchTask(task)
+	}
 
-				//fmt.Printf("Sending %d presigned messages to stader backend\n", len(preSignSendMessages))
-				fmt.Printf("Sending %d presigned messages to stader backend\n", len(preSignSendMessages))
-				//fmt.Printf("Pre-sign messages being sent are %v\n", preSignSendMessages)
-				if len(preSignSendMessages) > 0 {
-					res, err := stader.SendBulkPresignedMessageToStaderBackend(c, preSignSendMessages)
-					if err != nil {
-						errorLog.Printf("Sending bulk presigned message failed with %v\n", err.Error())
-					} else {
-						for pubKey, response := range *res {
-							if response.Success {
-								infoLog.Printf("Successfully sent the presigned message for validator: %s\n", pubKey)
-							} else {
-								errorLog.Printf("Failed to send the presigned api for validator: %s with err: %s\n", pubKey, response.Error)
-							}
-						}
-					}
+	if cfg.StaderNode.EnableAutoSendClRewards.Value == true {
+		sendClRewards, err := newSendClRewards(c, log.NewColorLogger(SendClRewardsColor))
+		if err != nil {
+			return err
+		}
+		task := scheduler.Task{
+			Name:          SendClRewardsTaskName,
+			Interval:      sendClRewardsInterval,
+			RetryInterval: taskRetryInterval,
+			Jitter:        sendClRewardsJitter,
+			Timeout:       sendClRewardsTimeout,
+			Run: func(ctx context.Context) error {
+				if err := waitClientsSynced(ctx, c, monitor); err != nil {
+					return err
 				}
+				return sendClRewards.run(ctx)
+			},
+		}
+		if err := taskScheduler.AddTask(task); err != nil {
+			return err
+		}
+		monitor.watchTask(task)
+	}
 
-				pageNumber += 1
-			}
+	if cfg.StaderNode.EnableEventIndexer.Value == true {
+		indexEvents, err := newIndexEvents(c, log.NewColorLogger(IndexEventsColor))
+		if err != nil {
+			return err
+		}
+		task := scheduler.Task{
+			Name:          IndexEventsTaskName,
+			Interval:      indexEventsInterval,
+			RetryInterval: taskRetryInterval,
+			Jitter:        indexEventsJitter,
+			Timeout:       indexEventsTimeout,
+			Run: func(ctx context.Context) error {
+				if err := waitClientsSynced(ctx, c, monitor); err != nil {
+					return err
+				}
+				return indexEvents.run(ctx)
+			},
+		}
+		if err := taskScheduler.AddTask(task); err != nil {
+			return err
+		}
+		monitor.watchTask(task)
+	}
 
-			infoLog.Printf("Done with the pass of presign daemon")
-			// run loop every 12 hours
-			time.Sleep(preSignedCooldown)
+	healthServerEnabled := cfg.StaderNode.EnableHealthServer.Value == true
+	if healthServerEnabled {
+		// Keep the primary / fallback status fresh for the readiness endpoint
+		err = taskScheduler.AddTask(scheduler.Task{
+			Name:     ClientStatusTaskName,
+			Interval: clientStatusInterval,
+			Timeout:  clientStatusTimeout,
+			Run:      monitor.refreshClientStatus,
+		})
+		if err != nil {
+			return err
 		}
+	}
 
-		wg.Done()
-	}()
+	// Stop scheduling new work when the container is stopped, and let the work in flight finish
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	// Run task loop
+	// Follow the voluntary exits on the Beacon chain
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
 	go func() {
-		for {
-			// Check the EC status
-			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
-			if err != nil {
-				errorLog.Println(err)
-			} else {
-				// Check the BC status
-				err := services.WaitBeaconClientSynced(c, false) // Force refresh the primary / fallback BC status
-				if err != nil {
-					errorLog.Println(err)
-				} else {
-					// Manage the fee recipient for the node
-					if err := manageFeeRecipient.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-				}
-			}
-			time.Sleep(feeRecepientPollingInterval)
+		defer wg.Done()
+		if err := exitWatcher.run(ctx); err != nil && ctx.Err() == nil {
+			errorLog.Println(err)
 		}
-		wg.Done()
 	}()
 
-	go func() {
-		for {
-			infoLog.Printlnf("Checking if there are any available merkle proofs to download")
-			// Check the EC status
-			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
-			if err != nil {
+	// Start the health server
+	if healthServerEnabled {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := monitor.serve(ctx, infoLog); err != nil {
 				errorLog.Println(err)
-			} else {
-				// Check the BC status
-				err := services.WaitBeaconClientSynced(c, false) // Force refresh the primary / fallback BC status
-				if err != nil {
-					errorLog.Println(err)
-				} else {
-					// Manage the fee recipient for the node
-					if err := merkleProofsDownloader.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-				}
 			}
-			infoLog.Printlnf("Done checking for merkle proofs to download")
-			time.Sleep(merkleProofsDownloadInterval)
-		}
-		wg.Done()
-	}()
+		}()
+	}
 
-	// Wait for both threads to stop
+	err = taskScheduler.Run(ctx)
+	stop()
 	wg.Wait()
-	return nil
+	return err
 
 }
 
+// Check the EC and BC status, force refreshing the primary / fallback status
+func waitClientsSynced(ctx context.Context, c *cli.Context, monitor *healthMonitor) error {
+	ecErr := services.WaitEthClientSyncedContext(ctx, c, false)
+	var bcErr error
+	if ecErr == nil {
+		bcErr = services.WaitBeaconClientSyncedContext(ctx, c, false)
+	}
+	monitor.recordClientSync(ecErr, bcErr)
+
+	if ecErr != nil {
+		return ecErr
+	}
+	return bcErr
+}
+
 // Configure HTTP transport settings
 func configureHTTP() {
 
@@ -0,0 +1,341 @@
+package node
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/presign"
+	"github.com/stader-labs/stader-node/shared/services/signer"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
+	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
+	"github.com/stader-labs/stader-node/shared/utils/crypto"
+	"github.com/stader-labs/stader-node/shared/utils/eth2"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/shared/utils/stader"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	staderlib "github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Number of presigned messages sent to the backend in a single request
+const presignBatchSize = 5
+
+// Presigned exit message task
+type submitPresignedExits struct {
+	c           *cli.Context
+	log         log.ColorLogger
+	errorLog    log.ColorLogger
+	cfg         *config.StaderConfig
+	w           *wallet.Wallet
+	vs          signer.ValidatorSigner
+	bc          beacon.Client
+	pnr         *staderlib.PermissionlessNodeRegistryContractManager
+	ledger      *presign.Ledger
+	exitStore   *presign.ExitStore
+	publicKey   *rsa.PublicKey
+	nodeAddress common.Address
+}
+
+// Create presigned exit message task
+func newSubmitPresignedExits(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger) (*submitPresignedExits, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	vs, err := services.GetValidatorSigner(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	ledger, err := services.GetPresignLedger(c)
+	if err != nil {
+		return nil, err
+	}
+	var exitStore *presign.ExitStore
+	if cfg.StaderNode.StorePresignedExits.Value == true {
+		exitStore, err = services.GetPresignedExitStore(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+	publicKey, err := stader.GetPublicKey(c)
+	if err != nil {
+		return nil, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &submitPresignedExits{
+		c:           c,
+		log:         logger,
+		errorLog:    errorLogger,
+		cfg:         cfg,
+		w:           w,
+		vs:          vs,
+		bc:          bc,
+		pnr:         pnr,
+		ledger:      ledger,
+		exitStore:   exitStore,
+		publicKey:   publicKey,
+		nodeAddress: nodeAccount.Address,
+	}, nil
+
+}
+
+// Run a pass of the presign daemon
+func (p *submitPresignedExits) run(ctx context.Context) error {
+
+	operatorId, err := node.GetOperatorId(p.pnr, p.nodeAddress, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get operator id: %w", err)
+	}
+
+	// make a map of all validators actually registered with stader
+	// user might just move the validator keys to the directory. we don't wanna send the presigned msg of them
+	p.log.Println("Building a map of user validators registered with stader")
+	registeredValidators, validatorPubKeys, err := stdr.GetAllValidatorsRegisteredWithOperator(p.pnr, operatorId, p.nodeAddress, nil)
+	if err != nil {
+		return fmt.Errorf("could not get all validators registered with operator %s: %w", operatorId, err)
+	}
+
+	p.log.Printlnf("Found %d validators registered with operator %s", len(registeredValidators), operatorId)
+	p.log.Println("Starting a pass of the presign daemon!")
+
+	// only process the validators which are new, whose last attempt did not complete, or whose contract state changed
+	pendingPubKeys := []types.ValidatorPubkey{}
+	for _, validatorPubKey := range validatorPubKeys {
+		validatorInfo := registeredValidators[validatorPubKey]
+		if !p.ledger.NeedsCheck(validatorPubKey, validatorInfo.Status) {
+			continue
+		}
+		if stdr.IsValidatorTerminal(validatorInfo) {
+			p.errorLog.Printf("Validator pub key: %s is in terminal state in the stader contracts\n", validatorPubKey)
+			p.ledger.RecordStatus(validatorPubKey, validatorInfo.Status, "")
+			p.ledger.RecordSkipped(validatorPubKey, "validator is in terminal state in the stader contracts")
+			continue
+		}
+		pendingPubKeys = append(pendingPubKeys, validatorPubKey)
+	}
+	p.log.Printlnf("%d of %d validators are new, failed or changed state since the last pass", len(pendingPubKeys), len(validatorPubKeys))
+	if len(pendingPubKeys) == 0 {
+		if err := p.ledger.Save(); err != nil {
+			return fmt.Errorf("could not save presign ledger: %w", err)
+		}
+		p.log.Printf("Done with the pass of presign daemon")
+		return nil
+	}
+
+	currentHead, err := p.bc.GetBeaconHead()
+	if err != nil {
+		return fmt.Errorf("could not get beacon head: %w", err)
+	}
+
+	// Exits are signed for the network's fork schedule rather than the fork the Beacon node reports
+	eth2Config, err := p.bc.GetEth2Config()
+	if err != nil {
+		return fmt.Errorf("could not get the eth2 config: %w", err)
+	}
+	schedule, err := eth2.GetCheckedForkSchedule(p.cfg.StaderNode.Network.Value.(cfgtypes.Network), eth2Config)
+	if err != nil {
+		return fmt.Errorf("could not get the fork schedule: %w", err)
+	}
+
+	err = p.w.Reload()
+	if err != nil {
+		return fmt.Errorf("could not reload wallet: %w", err)
+	}
+
+	preSignRegisteredMap, err := stader.BulkIsPresignedKeyRegistered(p.c, pendingPubKeys)
+	if err != nil {
+		return fmt.Errorf("could not bulk check presigned keys: %w", err)
+	}
+
+	for startIndex := 0; startIndex < len(pendingPubKeys); startIndex += presignBatchSize {
+		// stop between batches on shutdown, a batch is never cut off halfway
+		if ctx.Err() != nil {
+			return fmt.Errorf("presign pass interrupted: %w", ctx.Err())
+		}
+
+		endIndex := startIndex + presignBatchSize
+		if endIndex > len(pendingPubKeys) {
+			endIndex = len(pendingPubKeys)
+		}
+		p.log.Printf("Starting index: %d, End index: %d\n", startIndex, endIndex)
+
+		validatorKeyBatch := pendingPubKeys[startIndex:endIndex]
+		p.log.Printf("Checking %d validator keys\n", len(validatorKeyBatch))
+
+		preSignSendMessages := []stader_backend.PreSignSendApiRequestType{}
+		for _, validatorPubKey := range validatorKeyBatch {
+			preSignSendMessage, ok := p.createPresignedMessage(validatorPubKey, registeredValidators[validatorPubKey].Status, preSignRegisteredMap, currentHead.Epoch, schedule)
+			if ok {
+				preSignSendMessages = append(preSignSendMessages, preSignSendMessage)
+			}
+		}
+
+		p.sendPresignedMessages(preSignSendMessages)
+
+		// persist the batch so an interrupted pass does not lose what was handed over
+		if err := p.ledger.Save(); err != nil {
+			return fmt.Errorf("could not save presign ledger: %w", err)
+		}
+		if p.exitStore != nil {
+			if err := p.exitStore.Save(); err != nil {
+				return fmt.Errorf("could not save presigned exits: %w", err)
+			}
+		}
+	}
+
+	p.log.Printf("Done with the pass of presign daemon")
+	return nil
+
+}
+
+// Build the encrypted presigned exit message of a validator. Returns false if there is nothing to send.
+func (p *submitPresignedExits) createPresignedMessage(validatorPubKey types.ValidatorPubkey, contractStatus uint8, preSignRegisteredMap map[string]bool, exitEpoch uint64, schedule eth2.ForkSchedule) (stader_backend.PreSignSendApiRequestType, bool) {
+	p.log.Printf("Checking validator pubkey %s\n", validatorPubKey.String())
+	p.ledger.RecordStatus(validatorPubKey, contractStatus, "")
+
+	registeredPresign, ok := preSignRegisteredMap[validatorPubKey.String()]
+	if !ok {
+		p.errorLog.Printf("Could not query presign api to check if validator: %s is registered\n", validatorPubKey)
+		p.ledger.RecordError(validatorPubKey, "could not query presign api to check if the validator is registered")
+		return stader_backend.PreSignSendApiRequestType{}, false
+	}
+	if registeredPresign {
+		p.log.Printf("Validator pub key: %s pre signed key already registered\n", validatorPubKey)
+		p.ledger.RecordAcknowledged(validatorPubKey)
+		return stader_backend.PreSignSendApiRequestType{}, false
+	}
+	p.log.Printf("Validator pub key: %s pre signed key not registered. Creating presigned message\n", validatorPubKey)
+
+	err := p.vs.CheckValidatorKey(validatorPubKey)
+	if err != nil {
+		p.errorLog.Printf("Could not find validator key for %s with err: %s\n", validatorPubKey, err.Error())
+		p.ledger.RecordError(validatorPubKey, fmt.Sprintf("could not find validator key: %s", err.Error()))
+		return stader_backend.PreSignSendApiRequestType{}, false
+	}
+
+	// check if validator has not yet been registered on beacon chain
+	validatorStatus, err := p.bc.GetValidatorStatus(validatorPubKey, nil)
+	if err != nil {
+		p.errorLog.Printf("Error finding validator status for validator: %s with err: %s\n", validatorPubKey, err.Error())
+		p.ledger.RecordError(validatorPubKey, fmt.Sprintf("could not get validator status: %s", err.Error()))
+		return stader_backend.PreSignSendApiRequestType{}, false
+	}
+	if !validatorStatus.Exists {
+		p.errorLog.Printf("Validator pub key: %s not found on beacon chain\n", validatorPubKey)
+		p.ledger.RecordError(validatorPubKey, "validator not found on beacon chain")
+		return stader_backend.PreSignSendApiRequestType{}, false
+	}
+	p.ledger.RecordStatus(validatorPubKey, contractStatus, validatorStatus.Status)
+
+	// check if validator is already in an exiting phase, then no point sending a pre-signed message
+	if eth2.IsValidatorExiting(validatorStatus) {
+		p.errorLog.Printf("Validator pub key: %s already exiting or exited with status %s", validatorPubKey, validatorStatus.Status)
+		p.ledger.RecordSkipped(validatorPubKey, fmt.Sprintf("validator already exiting or exited with status %s", validatorStatus.Status))
+		return stader_backend.PreSignSendApiRequestType{}, false
+	}
+
+	// get the presigned msg
+	exitSignature, err := p.vs.SignVoluntaryExit(validatorPubKey, validatorStatus.Index, exitEpoch, schedule)
+	if err != nil {
+		p.errorLog.Printf("Failed to generate the SignedExitMessage for validator with beacon chain index: %d with err: %s\n", validatorStatus.Index, err.Error())
+		p.ledger.RecordError(validatorPubKey, fmt.Sprintf("could not generate the signed exit message: %s", err.Error()))
+		return stader_backend.PreSignSendApiRequestType{}, false
+	}
+
+	// encrypt the signature and srHash
+	exitSignatureEncrypted, err := crypto.EncryptUsingPublicKey([]byte(exitSignature.String()), p.publicKey)
+	if err != nil {
+		p.errorLog.Printf("Failed to encrypt exit signature for validator: %s with err: %s\n", validatorPubKey, err.Error())
+		p.ledger.RecordError(validatorPubKey, fmt.Sprintf("could not encrypt the exit signature: %s", err.Error()))
+		return stader_backend.PreSignSendApiRequestType{}, false
+	}
+	exitSignatureEncryptedString := crypto.EncodeBase64(exitSignatureEncrypted)
+
+	p.ledger.RecordSubmitted(validatorPubKey, validatorStatus.Index, exitEpoch)
+	if p.exitStore != nil {
+		p.exitStore.Record(presign.StoredExit{
+			Pubkey:         validatorPubKey,
+			ValidatorIndex: validatorStatus.Index,
+			Epoch:          exitEpoch,
+			Signature:      exitSignature,
+			SignedAt:       time.Now(),
+		})
+	}
+
+	return stader_backend.PreSignSendApiRequestType{
+		Message: struct {
+			Epoch          string `json:"epoch"`
+			ValidatorIndex string `json:"validator_index"`
+		}{
+			Epoch:          strconv.FormatUint(exitEpoch, 10),
+			ValidatorIndex: strconv.FormatUint(validatorStatus.Index, 10),
+		},
+		Signature:          exitSignatureEncryptedString,
+		ValidatorPublicKey: validatorPubKey.String(),
+	}, true
+}
+
+// Send a batch of presigned messages to the stader backend and record the result in the ledger
+func (p *submitPresignedExits) sendPresignedMessages(preSignSendMessages []stader_backend.PreSignSendApiRequestType) {
+	p.log.Printf("Sending %d presigned messages to stader backend\n", len(preSignSendMessages))
+	if len(preSignSendMessages) == 0 {
+		return
+	}
+
+	res, err := stader.SendBulkPresignedMessageToStaderBackend(p.c, preSignSendMessages)
+	if err != nil {
+		p.errorLog.Printf("Sending bulk presigned message failed with %v\n", err.Error())
+		for _, preSignSendMessage := range preSignSendMessages {
+			pubKey, err := types.HexToValidatorPubkey(preSignSendMessage.ValidatorPublicKey)
+			if err == nil {
+				p.ledger.RecordError(pubKey, "sending bulk presigned message failed")
+			}
+		}
+		return
+	}
+
+	for pubKey, response := range *res {
+		validatorPubKey, err := types.HexToValidatorPubkey(pubKey)
+		if err != nil {
+			p.errorLog.Printf("Stader backend returned an invalid validator pub key %s\n", pubKey)
+			continue
+		}
+		if response.Success {
+			p.log.Printf("Successfully sent the presigned message for validator: %s\n", pubKey)
+			p.ledger.RecordAcknowledged(validatorPubKey)
+		} else {
+			p.errorLog.Printf("Failed to send the presigned api for validator: %s with err: %s\n", pubKey, response.Error)
+			p.ledger.RecordError(validatorPubKey, response.Error)
+		}
+	}
+}
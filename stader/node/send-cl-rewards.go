@@ -0,0 +1,160 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	apiutils "github.com/stader-labs/stader-node/shared/utils/api"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+// Send CL rewards task
+type sendClRewards struct {
+	c      *cli.Context
+	log    log.ColorLogger
+	cfg    *config.StaderConfig
+	w      *wallet.Wallet
+	ec     stader.ExecutionClient
+	pnr    *stader.PermissionlessNodeRegistryContractManager
+	putils *stader.PoolUtilsContractManager
+	sdcfg  *stader.StaderConfigContractManager
+}
+
+// Create send CL rewards task
+func newSendClRewards(c *cli.Context, logger log.ColorLogger) (*sendClRewards, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	putils, err := services.GetPoolUtilsContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sdcfg, err := services.GetStaderConfigContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &sendClRewards{
+		c:      c,
+		log:    logger,
+		cfg:    cfg,
+		w:      w,
+		ec:     ec,
+		pnr:    pnr,
+		putils: putils,
+		sdcfg:  sdcfg,
+	}, nil
+
+}
+
+// Distribute the CL rewards of every withdraw vault whose operator share is worth the gas
+func (t *sendClRewards) run(ctx context.Context) error {
+
+	t.log.Println("Checking the CL rewards of the validator withdraw vaults...")
+
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	minimum := eth.EthToWei(t.cfg.StaderNode.AutoSendClRewardsMinimum.Value.(float64))
+	vaults, err := stdr.GetClRewardsVaults(t.pnr, t.putils, t.sdcfg, nodeAccount.Address, minimum, nil)
+	if err != nil {
+		return err
+	}
+
+	eligibleVaults := []stdr.ClRewardsVault{}
+	for _, vault := range vaults {
+		if vault.State == stdr.ClRewardsVaultState_Eligible {
+			eligibleVaults = append(eligibleVaults, vault)
+			continue
+		}
+		t.log.Printlnf("Validator %s: vault %s, operator share %.6f ETH, %s, skipping.", vault.Pubkey, vault.WithdrawVaultAddress.Hex(), eth.WeiToEth(vault.OperatorShare), vault.State)
+	}
+	if len(eligibleVaults) == 0 {
+		t.log.Println("No CL rewards to send.")
+		return nil
+	}
+
+	// Check the base fee is low enough
+	header, err := t.ec.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error getting the latest block header: %w", err)
+	}
+	maxBaseFeeGwei := t.cfg.StaderNode.AutoSendClRewardsMaxBaseFee.Value.(float64)
+	if header.BaseFee != nil && header.BaseFee.Cmp(eth.GweiToWei(maxBaseFeeGwei)) > 0 {
+		t.log.Printlnf("Current base fee of %.2f gwei is above the ceiling of %.2f gwei, postponing sending the CL rewards of %d vaults.", eth.WeiToGwei(header.BaseFee), maxBaseFeeGwei, len(eligibleVaults))
+		return nil
+	}
+
+	// Send the rewards of each vault, carrying on past failures
+	failed := 0
+	for _, vault := range eligibleVaults {
+		if ctx.Err() != nil {
+			return fmt.Errorf("sending CL rewards interrupted: %w", ctx.Err())
+		}
+
+		if err := t.distributeRewards(vault); err != nil {
+			t.log.Printlnf("Validator %s: vault %s, operator share %.6f ETH, failed: %s", vault.Pubkey, vault.WithdrawVaultAddress.Hex(), eth.WeiToEth(vault.OperatorShare), err.Error())
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("could not send the CL rewards of %d of %d vaults", failed, len(eligibleVaults))
+	}
+	return nil
+
+}
+
+// Distribute the CL rewards of a single withdraw vault
+func (t *sendClRewards) distributeRewards(vault stdr.ClRewardsVault) error {
+
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+	gasInfo, err := node.EstimateDistributeRewards(t.ec, vault.WithdrawVaultAddress, opts)
+	if err != nil {
+		return fmt.Errorf("error estimating gas: %w", err)
+	}
+	opts.GasLimit = gasInfo.SafeGasLimit
+
+	tx, err := node.DistributeRewards(t.ec, vault.WithdrawVaultAddress, opts)
+	if err != nil {
+		return err
+	}
+	err = apiutils.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.ec, t.log)
+	if err != nil {
+		return err
+	}
+
+	t.log.Printlnf("Validator %s: vault %s, operator share %.6f ETH, sent in transaction %s.", vault.Pubkey, vault.WithdrawVaultAddress.Hex(), eth.WeiToEth(vault.OperatorShare), tx.Hash().Hex())
+	return nil
+
+}
@@ -0,0 +1,248 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
+	apiutils "github.com/stader-labs/stader-node/shared/utils/api"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	pool_utils "github.com/stader-labs/stader-node/stader-lib/pool-utils"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/tokens"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+// The permissionless pool the node EL vault belongs to
+const permissionlessPoolId = 1
+
+// Below this, the EL vault withdrawal reverts
+var minElRewardsToSweep = big.NewInt(1000000000)
+
+// Sweep EL vault and operator rewards collector task
+type sweepRewards struct {
+	c      *cli.Context
+	log    log.ColorLogger
+	cfg    *config.StaderConfig
+	w      *wallet.Wallet
+	ec     stader.ExecutionClient
+	pnr    *stader.PermissionlessNodeRegistryContractManager
+	putils *stader.PoolUtilsContractManager
+	orc    *stader.OperatorRewardsCollectorContractManager
+}
+
+// Create sweep rewards task
+func newSweepRewards(c *cli.Context, logger log.ColorLogger) (*sweepRewards, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	putils, err := services.GetPoolUtilsContract(c)
+	if err != nil {
+		return nil, err
+	}
+	orc, err := services.GetOperatorRewardsCollectorContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &sweepRewards{
+		c:      c,
+		log:    logger,
+		cfg:    cfg,
+		w:      w,
+		ec:     ec,
+		pnr:    pnr,
+		putils: putils,
+		orc:    orc,
+	}, nil
+
+}
+
+// Sweep the EL vault into the operator rewards collector, then claim the collector to the operator reward address
+func (t *sweepRewards) run(ctx context.Context) error {
+
+	mode := t.cfg.StaderNode.AutoSweepMode.Value.(cfgtypes.AutoSweepMode)
+
+	t.log.Println("Checking the EL reward vault and operator rewards collector balances...")
+
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	operatorId, err := node.GetOperatorId(t.pnr, nodeAccount.Address, nil)
+	if err != nil {
+		return err
+	}
+	operatorInfo, err := node.GetOperatorInfo(t.pnr, operatorId, nil)
+	if err != nil {
+		return err
+	}
+
+	// Get the operator share of the EL vault
+	elRewardAddress, err := node.GetNodeElRewardAddress(t.pnr, permissionlessPoolId, operatorId, nil)
+	if err != nil {
+		return err
+	}
+	elRewardAddressBalance, err := tokens.GetEthBalance(t.ec, elRewardAddress, nil)
+	if err != nil {
+		return err
+	}
+	elRewards, err := pool_utils.CalculateRewardShare(t.putils, permissionlessPoolId, elRewardAddressBalance, nil)
+	if err != nil {
+		return err
+	}
+	elShare := elRewards.OperatorShare
+
+	collectorBalance, err := node.GetOperatorRewardsCollectorBalance(t.orc, nodeAccount.Address, nil)
+	if err != nil {
+		return err
+	}
+
+	elThreshold := eth.EthToWei(t.cfg.StaderNode.AutoSweepElVaultThreshold.Value.(float64))
+	collectorThreshold := eth.EthToWei(t.cfg.StaderNode.AutoSweepRewardsCollectorThreshold.Value.(float64))
+	sweepElVault := elShare.Cmp(elThreshold) >= 0 && elShare.Cmp(minElRewardsToSweep) >= 0
+	claimCollector := collectorBalance.Cmp(collectorThreshold) >= 0
+	if !sweepElVault && !claimCollector {
+		t.log.Printlnf("EL vault share of %.6f ETH and rewards collector balance of %.6f ETH are below their thresholds, nothing to sweep.",
+			eth.WeiToEth(elShare), eth.WeiToEth(collectorBalance))
+		return nil
+	}
+
+	if sweepElVault {
+		t.log.Printlnf("EL vault %s holds %.6f ETH for the operator, above the threshold of %.6f ETH.", elRewardAddress.Hex(), eth.WeiToEth(elShare), eth.WeiToEth(elThreshold))
+	}
+	if claimCollector {
+		t.log.Printlnf("Operator rewards collector holds %.6f ETH for the operator, above the threshold of %.6f ETH.", eth.WeiToEth(collectorBalance), eth.WeiToEth(collectorThreshold))
+	}
+
+	if mode == cfgtypes.AutoSweepMode_Notify {
+		t.log.Printlnf("Rewards are due to be swept to operator reward address %s. Run `stader-cli node send-el-rewards` and `stader-cli node claim-rewards`, or set the auto sweep mode to enabled.", operatorInfo.OperatorRewardAddress.Hex())
+		return nil
+	}
+
+	// Check the base fee is low enough
+	header, err := t.ec.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error getting the latest block header: %w", err)
+	}
+	maxBaseFeeGwei := t.cfg.StaderNode.AutoSweepMaxBaseFee.Value.(float64)
+	if header.BaseFee != nil && header.BaseFee.Cmp(eth.GweiToWei(maxBaseFeeGwei)) > 0 {
+		t.log.Printlnf("Current base fee of %.2f gwei is above the ceiling of %.2f gwei, postponing the sweep.", eth.WeiToGwei(header.BaseFee), maxBaseFeeGwei)
+		return nil
+	}
+
+	if sweepElVault {
+		if err := t.withdrawElVault(mode, elRewardAddress, elShare); err != nil {
+			return err
+		}
+	}
+	if ctx.Err() != nil {
+		return fmt.Errorf("rewards sweep interrupted: %w", ctx.Err())
+	}
+
+	// The withdrawal moves the operator share into the collector, so claim whatever it holds now
+	if mode == cfgtypes.AutoSweepMode_Enabled {
+		collectorBalance, err = node.GetOperatorRewardsCollectorBalance(t.orc, nodeAccount.Address, nil)
+		if err != nil {
+			return err
+		}
+	}
+	if collectorBalance.Sign() == 0 {
+		if mode == cfgtypes.AutoSweepMode_DryRun && sweepElVault {
+			t.log.Printlnf("[dry run] Would then claim the EL vault share from the rewards collector to %s.", operatorInfo.OperatorRewardAddress.Hex())
+		}
+		return nil
+	}
+	return t.claimCollector(mode, collectorBalance, operatorInfo.OperatorRewardAddress)
+
+}
+
+// Send the operator share of the EL vault to the operator rewards collector
+func (t *sweepRewards) withdrawElVault(mode cfgtypes.AutoSweepMode, elRewardAddress common.Address, elShare *big.Int) error {
+
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+	gasInfo, err := node.EstimateWithdrawFromNodeElVault(t.ec, elRewardAddress, opts)
+	if err != nil {
+		return fmt.Errorf("error estimating the gas of the EL vault withdrawal: %w", err)
+	}
+
+	if mode == cfgtypes.AutoSweepMode_DryRun {
+		t.log.Printlnf("[dry run] Would withdraw %.6f ETH from EL vault %s, estimated gas %d.", eth.WeiToEth(elShare), elRewardAddress.Hex(), gasInfo.EstGasLimit)
+		return nil
+	}
+
+	opts.GasLimit = gasInfo.SafeGasLimit
+	t.log.Printlnf("Withdrawing %.6f ETH from EL vault %s...", eth.WeiToEth(elShare), elRewardAddress.Hex())
+	tx, err := node.WithdrawFromNodeElVault(t.ec, elRewardAddress, opts)
+	if err != nil {
+		return fmt.Errorf("error withdrawing from the EL vault: %w", err)
+	}
+	err = apiutils.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.ec, t.log)
+	if err != nil {
+		return err
+	}
+	t.log.Printlnf("Successfully withdrew the EL vault rewards in transaction %s.", tx.Hash().Hex())
+
+	return nil
+
+}
+
+// Claim the operator rewards collector balance to the operator reward address
+func (t *sweepRewards) claimCollector(mode cfgtypes.AutoSweepMode, collectorBalance *big.Int, operatorRewardAddress common.Address) error {
+
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+	gasInfo, err := node.EstimateClaimOperatorRewards(t.orc, opts)
+	if err != nil {
+		return fmt.Errorf("error estimating the gas of the rewards claim: %w", err)
+	}
+
+	if mode == cfgtypes.AutoSweepMode_DryRun {
+		t.log.Printlnf("[dry run] Would claim %.6f ETH from the rewards collector to %s, estimated gas %d.", eth.WeiToEth(collectorBalance), operatorRewardAddress.Hex(), gasInfo.EstGasLimit)
+		return nil
+	}
+
+	opts.GasLimit = gasInfo.SafeGasLimit
+	t.log.Printlnf("Claiming %.6f ETH from the rewards collector to %s...", eth.WeiToEth(collectorBalance), operatorRewardAddress.Hex())
+	tx, err := node.ClaimOperatorRewards(t.orc, opts)
+	if err != nil {
+		return fmt.Errorf("error claiming the operator rewards: %w", err)
+	}
+	err = apiutils.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.ec, t.log)
+	if err != nil {
+		return err
+	}
+	t.log.Printlnf("Successfully claimed the operator rewards in transaction %s.", tx.Hash().Hex())
+
+	return nil
+
+}
@@ -0,0 +1,127 @@
+package node
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Config
+var exitWatcherRefreshInterval, _ = time.ParseDuration("10m")
+
+// Watches the Beacon node's event stream for voluntary exits of the operator's validators
+type exitWatcher struct {
+	log log.ColorLogger
+	w   *wallet.Wallet
+	pnr *stader.PermissionlessNodeRegistryContractManager
+	bc  *services.BeaconClientManager
+
+	// The operator's validators by index, refreshed when an unknown index exits
+	validators  map[uint64]types.ValidatorPubkey
+	refreshedAt time.Time
+	lock        sync.Mutex
+}
+
+// Create exit watcher
+func newExitWatcher(c *cli.Context, logger log.ColorLogger) (*exitWatcher, error) {
+
+	// Get services
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return watcher
+	return &exitWatcher{
+		log:        logger,
+		w:          w,
+		pnr:        pnr,
+		bc:         bc,
+		validators: map[uint64]types.ValidatorPubkey{},
+	}, nil
+
+}
+
+// Follow the voluntary exits until the context is cancelled; the stream is reopened whenever it fails
+func (e *exitWatcher) run(ctx context.Context) error {
+	e.log.Println("Watching the Beacon node's event stream for voluntary exits of the operator's validators.")
+	return e.bc.SubscribeEvents(ctx, []beacon.EventTopic{beacon.EventTopic_VoluntaryExit}, e.handle)
+}
+
+// Report the exit if it is one of the operator's validators
+func (e *exitWatcher) handle(event beacon.Event) {
+	if event.VoluntaryExit == nil {
+		return
+	}
+	pubkey, isOperatorValidator := e.getOperatorValidator(event.VoluntaryExit.ValidatorIndex)
+	if !isOperatorValidator {
+		return
+	}
+	e.log.Printlnf("WARNING: A voluntary exit of validator %s (index %d) at epoch %d was broadcast to the Beacon chain.", pubkey.Hex(), event.VoluntaryExit.ValidatorIndex, event.VoluntaryExit.Epoch)
+}
+
+// Get the pubkey of the operator's validator with the given index
+func (e *exitWatcher) getOperatorValidator(index uint64) (types.ValidatorPubkey, bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if pubkey, exists := e.validators[index]; exists {
+		return pubkey, true
+	}
+
+	// Most exits belong to other operators, so the validators are only reloaded once in a while
+	if time.Since(e.refreshedAt) < exitWatcherRefreshInterval {
+		return types.ValidatorPubkey{}, false
+	}
+	e.refreshedAt = time.Now()
+	if err := e.refreshValidators(); err != nil {
+		e.log.Printlnf("WARNING: Could not load the operator's validators: %s", err.Error())
+		return types.ValidatorPubkey{}, false
+	}
+	pubkey, exists := e.validators[index]
+	return pubkey, exists
+}
+
+// Load the indices of the operator's validators from the Beacon node
+func (e *exitWatcher) refreshValidators() error {
+	nodeAccount, err := e.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	validators, err := node.GetAllValidatorsInfoByOperator(e.pnr, nodeAccount.Address, nil)
+	if err != nil {
+		return err
+	}
+	pubkeys := make([]types.ValidatorPubkey, 0, len(validators))
+	for _, validator := range validators {
+		pubkeys = append(pubkeys, types.BytesToValidatorPubkey(validator.Pubkey))
+	}
+	statuses, err := e.bc.GetValidatorStatuses(pubkeys, nil)
+	if err != nil {
+		return err
+	}
+	for pubkey, status := range statuses {
+		if status.Exists {
+			e.validators[status.Index] = pubkey
+		}
+	}
+	return nil
+}
@@ -781,6 +781,10 @@ Public License instead of this License. But first, please read
 			Name:  "nonce",
 			Usage: "Use this flag to explicitly specify the nonce that this transaction should use, so it can override an existing 'stuck' transaction",
 		},
+		cli.BoolFlag{
+			Name:  "unsigned-tx",
+			Usage: "Build write transactions for the node account without signing or sending them, so they can be signed offline",
+		},
 		cli.StringFlag{
 			Name:  "metricsAddress, m",
 			Usage: "Address to serve metrics on if enabled",
@@ -804,6 +808,7 @@ Public License instead of this License. But first, please read
 	// Register commands
 	api.RegisterCommands(app, "api", []string{"a"})
 	node.RegisterCommands(app, "node", []string{"n"})
+	node.RegisterHealthCheckCommand(app, "health-check", []string{"hc"})
 	guardian.RegisterCommands(app, "guardian", []string{"w"})
 
 	// Get command being run